@@ -1,8 +1,10 @@
 package gojay
 
 import (
+	"encoding/base64"
 	"fmt"
 	"io"
+	"math/big"
 )
 
 // UnmarshalJSONArray parses the JSON-encoded data and stores the result in the value pointed to by v.
@@ -12,11 +14,23 @@ import (
 // If a JSON value is not appropriate for a given target type, or if a JSON number
 // overflows the target type, UnmarshalJSONArray skips that field and completes the unmarshaling as best it can.
 func UnmarshalJSONArray(data []byte, v UnmarshalerJSONArray) error {
+	return UnmarshalJSONArrayWithOptions(data, v)
+}
+
+// UnmarshalJSONArrayWithOptions is like UnmarshalJSONArray but configures the Decoder with the given options first.
+func UnmarshalJSONArrayWithOptions(data []byte, v UnmarshalerJSONArray, opts ...DecoderOption) error {
 	dec := borrowDecoder(nil, 0)
 	defer dec.Release()
+	dec.applyOptions(opts)
 	dec.data = make([]byte, len(data))
 	copy(dec.data, data)
 	dec.length = len(data)
+	if !dec.checkMaxBytes() {
+		return dec.err
+	}
+	if err := dec.checkStrictDocument(); err != nil {
+		return err
+	}
 	_, err := dec.decodeArray(v)
 	if err != nil {
 		return err
@@ -34,11 +48,23 @@ func UnmarshalJSONArray(data []byte, v UnmarshalerJSONArray) error {
 // If a JSON value is not appropriate for a given target type, or if a JSON number
 // overflows the target type, UnmarshalJSONObject skips that field and completes the unmarshaling as best it can.
 func UnmarshalJSONObject(data []byte, v UnmarshalerJSONObject) error {
+	return UnmarshalJSONObjectWithOptions(data, v)
+}
+
+// UnmarshalJSONObjectWithOptions is like UnmarshalJSONObject but configures the Decoder with the given options first.
+func UnmarshalJSONObjectWithOptions(data []byte, v UnmarshalerJSONObject, opts ...DecoderOption) error {
 	dec := borrowDecoder(nil, 0)
 	defer dec.Release()
+	dec.applyOptions(opts)
 	dec.data = make([]byte, len(data))
 	copy(dec.data, data)
 	dec.length = len(data)
+	if !dec.checkMaxBytes() {
+		return dec.err
+	}
+	if err := dec.checkStrictDocument(); err != nil {
+		return err
+	}
 	_, err := dec.decodeObject(v)
 	if err != nil {
 		return err
@@ -52,7 +78,8 @@ func UnmarshalJSONObject(data []byte, v UnmarshalerJSONObject) error {
 // Unmarshal parses the JSON-encoded data and stores the result in the value pointed to by v.
 // If v is nil, not an implementation of UnmarshalerJSONObject or UnmarshalerJSONArray or not one of the following types:
 // 	*string, **string, *int, **int, *int8, **int8, *int16, **int16, *int32, **int32, *int64, **int64, *uint8, **uint8, *uint16, **uint16,
-// 	*uint32, **uint32, *uint64, **uint64, *float64, **float64, *float32, **float32, *bool, **bool
+// 	*uint32, **uint32, *uint64, **uint64, *float64, **float64, *float32, **float32, *bool, **bool,
+// 	*big.Int, **big.Int, *big.Float, **big.Float, *Number, **Number, *[]byte
 // Unmarshal returns an InvalidUnmarshalError.
 //
 //
@@ -61,161 +88,110 @@ func UnmarshalJSONObject(data []byte, v UnmarshalerJSONObject) error {
 // If no more serious errors are encountered, Unmarshal returns an UnmarshalTypeError describing the earliest such error.
 // In any case, it's not guaranteed that all the remaining fields following the problematic one will be unmarshaled into the target object.
 func Unmarshal(data []byte, v interface{}) error {
+	return UnmarshalWithOptions(data, v)
+}
+
+// UnmarshalWithOptions is like Unmarshal but configures the Decoder with the given options first.
+//
+// Example:
+//	err := gojay.UnmarshalWithOptions(data, &v, gojay.Strict())
+func UnmarshalWithOptions(data []byte, v interface{}, opts ...DecoderOption) error {
 	var err error
-	var dec *Decoder
+	dec := borrowDecoder(nil, 0)
+	defer dec.Release()
+	dec.applyOptions(opts)
+	dec.length = len(data)
+	dec.data = data
+	if !dec.checkMaxBytes() {
+		return dec.err
+	}
+	if err = dec.checkStrictDocument(); err != nil {
+		return err
+	}
 	switch vt := v.(type) {
 	case *string:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeString(vt)
 	case **string:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeStringNull(vt)
 	case *int:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeInt(vt)
 	case **int:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeIntNull(vt)
 	case *int8:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeInt8(vt)
 	case **int8:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeInt8Null(vt)
 	case *int16:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeInt16(vt)
 	case **int16:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeInt16Null(vt)
 	case *int32:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeInt32(vt)
 	case **int32:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeInt32Null(vt)
 	case *int64:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeInt64(vt)
 	case **int64:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeInt64Null(vt)
 	case *uint8:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeUint8(vt)
 	case **uint8:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeUint8Null(vt)
 	case *uint16:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeUint16(vt)
 	case **uint16:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeUint16Null(vt)
 	case *uint32:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeUint32(vt)
 	case **uint32:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeUint32Null(vt)
 	case *uint64:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeUint64(vt)
 	case **uint64:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeUint64Null(vt)
 	case *float64:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeFloat64(vt)
 	case **float64:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeFloat64Null(vt)
 	case *float32:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeFloat32(vt)
 	case **float32:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeFloat32Null(vt)
 	case *bool:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeBool(vt)
 	case **bool:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
-		dec.data = data
 		err = dec.decodeBoolNull(vt)
+	case *big.Int:
+		err = dec.decodeBigInt(vt)
+	case **big.Int:
+		err = dec.decodeBigIntNull(vt)
+	case *big.Float:
+		err = dec.decodeBigFloat(vt)
+	case **big.Float:
+		err = dec.decodeBigFloatNull(vt)
+	case *Number:
+		err = dec.decodeNumber(vt)
+	case **Number:
+		err = dec.decodeNumberNull(vt)
+	case *[]byte:
+		dec.data = make([]byte, len(data))
+		copy(dec.data, data)
+		err = dec.decodeBytes(vt, base64.StdEncoding)
 	case UnmarshalerJSONObject:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
 		dec.data = make([]byte, len(data))
 		copy(dec.data, data)
 		_, err = dec.decodeObject(vt)
 	case UnmarshalerJSONArray:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
 		dec.data = make([]byte, len(data))
 		copy(dec.data, data)
 		_, err = dec.decodeArray(vt)
 	case *interface{}:
-		dec = borrowDecoder(nil, 0)
-		dec.length = len(data)
 		dec.data = make([]byte, len(data))
 		copy(dec.data, data)
 		err = dec.decodeInterface(vt)
 	default:
 		return InvalidUnmarshalError(fmt.Sprintf(invalidUnmarshalErrorMsg, vt))
 	}
-	defer dec.Release()
 	if err != nil {
 		return err
 	}
@@ -228,6 +204,35 @@ type UnmarshalerJSONObject interface {
 	NKeys() int
 }
 
+// UnmarshalerKeys is the interface an UnmarshalerJSONObject can implement
+// to list the keys its UnmarshalJSONObject method decodes, as they are written in its switch.
+// With case insensitive keys (see Decoder.UseCaseInsensitiveKeys), a key of the JSON input
+// equal to one of them ignoring ASCII case is given to UnmarshalJSONObject as it is listed.
+type UnmarshalerKeys interface {
+	Keys() []string
+}
+
+// UnmarshalerRequiredKeys is the interface an UnmarshalerJSONObject can implement
+// to list the keys which must be present in the JSON object.
+// If some of them are missing once the object is decoded, a *MissingKeysError is returned.
+type UnmarshalerRequiredKeys interface {
+	RequiredKeys() []string
+}
+
+// UnmarshalerKeyAliases is the interface an UnmarshalerRequiredKeys can implement
+// when its UnmarshalJSONObject method accepts required keys under other names.
+// KeyAliases maps each alias to the required key it stands for, so that the key is found under its alias.
+type UnmarshalerKeyAliases interface {
+	KeyAliases() map[string]string
+}
+
+// UnknownKeysCollector is the interface an UnmarshalerJSONObject can implement
+// to collect the keys its UnmarshalJSONObject method does not decode, along with their raw value,
+// instead of having them skipped. See UnknownKeys.
+type UnknownKeysCollector interface {
+	CollectUnknownKey(key string, value EmbeddedJSON)
+}
+
 // UnmarshalerJSONArray is the interface to implement to decode a JSON Array.
 type UnmarshalerJSONArray interface {
 	UnmarshalJSONArray(*Decoder) error
@@ -235,16 +240,36 @@ type UnmarshalerJSONArray interface {
 
 // A Decoder reads and decodes JSON values from an input stream.
 type Decoder struct {
-	r          io.Reader
-	data       []byte
-	err        error
-	isPooled   byte
-	called     byte
-	child      byte
-	cursor     int
-	length     int
-	keysDone   int
-	arrayIndex int
+	r                   io.Reader
+	data                []byte
+	err                 error
+	isPooled            byte
+	called              byte
+	child               byte
+	cursor              int
+	length              int
+	keysDone            int
+	arrayIndex          int
+	useNumber           bool
+	strict              bool
+	detailed            bool
+	allErrors           bool
+	disallowUnknown     bool
+	caseInsensitiveKeys bool
+	quotedNumbers       bool
+	intPolicy           *IntPolicy
+	maxDepth            int
+	depth               int
+	maxBytes            int
+	maxStringLength     int
+	maxArrayLength      int
+	maxObjectKeys       int
+	limitErr            error
+	errs                DecodeErrors
+	path                []pathSegment
+	offset              int
+	line                int
+	column              int
 }
 
 // Decode reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the value pointed to by v.
@@ -256,6 +281,26 @@ func (dec *Decoder) Reset() {
 	dec.length = 0
 	dec.isPooled = 0
 	dec.arrayIndex = 0
+	dec.useNumber = false
+	dec.strict = false
+	dec.detailed = false
+	dec.allErrors = false
+	dec.disallowUnknown = false
+	dec.caseInsensitiveKeys = false
+	dec.quotedNumbers = false
+	dec.intPolicy = nil
+	dec.maxDepth = DefaultMaxDepth
+	dec.depth = 0
+	dec.maxBytes = 0
+	dec.maxStringLength = 0
+	dec.maxArrayLength = 0
+	dec.maxObjectKeys = 0
+	dec.limitErr = nil
+	dec.errs = nil
+	dec.path = dec.path[:0]
+	dec.offset = 0
+	dec.line = 0
+	dec.column = 0
 }
 
 // See the documentation for Unmarshal for details about the conversion of JSON into a Go value.
@@ -266,6 +311,9 @@ func (dec *Decoder) Decode(v interface{}) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	var err error
 	switch vt := v.(type) {
 	case *string:
@@ -320,6 +368,20 @@ func (dec *Decoder) Decode(v interface{}) error {
 		err = dec.decodeBool(vt)
 	case **bool:
 		err = dec.decodeBoolNull(vt)
+	case *big.Int:
+		err = dec.decodeBigInt(vt)
+	case **big.Int:
+		err = dec.decodeBigIntNull(vt)
+	case *big.Float:
+		err = dec.decodeBigFloat(vt)
+	case **big.Float:
+		err = dec.decodeBigFloatNull(vt)
+	case *Number:
+		err = dec.decodeNumber(vt)
+	case **Number:
+		err = dec.decodeNumberNull(vt)
+	case *[]byte:
+		err = dec.decodeBytes(vt, base64.StdEncoding)
 	case UnmarshalerJSONObject:
 		_, err = dec.decodeObject(vt)
 	case UnmarshalerJSONArray:
@@ -351,7 +413,7 @@ func isDigit(b byte) bool {
 }
 
 func (dec *Decoder) read() bool {
-	if dec.r != nil {
+	if dec.r != nil && dec.limitErr == nil {
 		// if we reach the end, double the buffer to ensure there's always more space
 		if len(dec.data) == dec.length {
 			nLen := dec.length * 2
@@ -362,10 +424,17 @@ func (dec *Decoder) read() bool {
 			copy(Buf, dec.data)
 			dec.data = Buf
 		}
+		buf := dec.data[dec.length:]
+		if dec.maxBytes > 0 {
+			// read one byte more than allowed to know if the input exceeds the limit
+			if room := dec.maxBytes - dec.offset - dec.length + 1; len(buf) > room {
+				buf = buf[:room]
+			}
+		}
 		var n int
 		var err error
 		for n == 0 {
-			n, err = dec.r.Read(dec.data[dec.length:])
+			n, err = dec.r.Read(buf)
 			if err != nil {
 				if err != io.EOF {
 					dec.err = err
@@ -375,11 +444,11 @@ func (dec *Decoder) read() bool {
 					return false
 				}
 				dec.length = dec.length + n
-				return true
+				return dec.checkMaxBytes()
 			}
 		}
 		dec.length = dec.length + n
-		return true
+		return dec.checkMaxBytes()
 	}
 	return false
 }
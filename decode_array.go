@@ -14,22 +14,37 @@ func (dec *Decoder) DecodeArray(v UnmarshalerJSONArray) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	_, err := dec.decodeArray(v)
 	return err
 }
 func (dec *Decoder) decodeArray(arr UnmarshalerJSONArray) (int, error) {
+	depth := dec.pushPath()
 	// remember last array index in case of nested arrays
 	lastArrayIndex := dec.arrayIndex
 	dec.arrayIndex = 0
-	defer func() {
-		dec.arrayIndex = lastArrayIndex
-	}()
+	lastDepth := dec.depth
+	end, err := dec.decodeArrayAt(arr, depth)
+	dec.arrayIndex = lastArrayIndex
+	dec.depth = lastDepth
+	dec.popPath(depth)
+	return end, err
+}
+
+// decodeArrayAt decodes the array at the cursor, depth being its segment in the path, see Decoder.pushPath.
+func (dec *Decoder) decodeArrayAt(arr UnmarshalerJSONArray, depth int) (int, error) {
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
 		case ' ', '\n', '\t', '\r', ',':
 			continue
 		case '[':
 			dec.cursor = dec.cursor + 1
+			if err := dec.incDepth(); err != nil {
+				return 0, err
+			}
+			dec.setPathIndex(depth)
 			// array is open, char is not space start readings
 			for dec.nextChar() != 0 {
 				// closing array
@@ -39,6 +54,9 @@ func (dec *Decoder) decodeArray(arr UnmarshalerJSONArray) (int, error) {
 					return dec.cursor, nil
 				}
 
+				if exceedsLimit(dec.maxArrayLength, dec.arrayIndex+1) {
+					return 0, dec.raiseLimitErr(LimitArrayLength, dec.maxArrayLength, dec.cursor)
+				}
 				// calling unmarshall function for each element of the slice
 				err := arr.UnmarshalJSONArray(dec)
 				if err != nil {
@@ -58,8 +76,7 @@ func (dec *Decoder) decodeArray(arr UnmarshalerJSONArray) (int, error) {
 		case '{', '"', 'f', 't', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			// can't unmarshall to struct
 			// we skip array and set Error
-			dec.err = dec.makeInvalidUnmarshalErr(arr)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(arr, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return 0, err
 			}
@@ -71,12 +88,21 @@ func (dec *Decoder) decodeArray(arr UnmarshalerJSONArray) (int, error) {
 	return 0, dec.raiseInvalidJSONErr(dec.cursor)
 }
 func (dec *Decoder) decodeArrayNull(v interface{}) (int, error) {
+	depth := dec.pushPath()
 	// remember last array index in case of nested arrays
 	lastArrayIndex := dec.arrayIndex
 	dec.arrayIndex = 0
-	defer func() {
-		dec.arrayIndex = lastArrayIndex
-	}()
+	lastDepth := dec.depth
+	end, err := dec.decodeArrayNullAt(v, depth)
+	dec.arrayIndex = lastArrayIndex
+	dec.depth = lastDepth
+	dec.popPath(depth)
+	return end, err
+}
+
+// decodeArrayNullAt decodes the array at the cursor to v, a pointer to a pointer,
+// depth being its segment in the path, see Decoder.pushPath.
+func (dec *Decoder) decodeArrayNullAt(v interface{}, depth int) (int, error) {
 	vv := reflect.ValueOf(v)
 	vvt := vv.Type()
 	if vvt.Kind() != reflect.Ptr || vvt.Elem().Kind() != reflect.Ptr {
@@ -91,6 +117,10 @@ func (dec *Decoder) decodeArrayNull(v interface{}) (int, error) {
 			continue
 		case '[':
 			dec.cursor = dec.cursor + 1
+			if err := dec.incDepth(); err != nil {
+				return 0, err
+			}
+			dec.setPathIndex(depth)
 			// create our new type
 			elt := vv.Elem()
 			n := reflect.New(elt.Type().Elem())
@@ -108,6 +138,9 @@ func (dec *Decoder) decodeArrayNull(v interface{}) (int, error) {
 					dec.cursor = dec.cursor + 1
 					return dec.cursor, nil
 				}
+				if exceedsLimit(dec.maxArrayLength, dec.arrayIndex+1) {
+					return 0, dec.raiseLimitErr(LimitArrayLength, dec.maxArrayLength, dec.cursor)
+				}
 				// calling unmarshall function for each element of the slice
 				err := arr.UnmarshalJSONArray(dec)
 				if err != nil {
@@ -127,8 +160,7 @@ func (dec *Decoder) decodeArrayNull(v interface{}) (int, error) {
 		case '{', '"', 'f', 't', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			// can't unmarshall to struct
 			// we skip array and set Error
-			dec.err = dec.makeInvalidUnmarshalErr((UnmarshalerJSONArray)(nil))
-			err := dec.skipData()
+			err := dec.skipInvalidValue((UnmarshalerJSONArray)(nil), dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return 0, err
 			}
@@ -141,20 +173,37 @@ func (dec *Decoder) decodeArrayNull(v interface{}) (int, error) {
 }
 
 func (dec *Decoder) skipArray() (int, error) {
+	if dec.hasElementLimits() {
+		return dec.skipArrayLimited()
+	}
 	var arraysOpen = 1
 	var arraysClosed = 0
+	// nested counts the objects and arrays open, to enforce the maximum depth
+	var nested = 1
+	if dec.exceedsDepth(nested) {
+		return 0, dec.raiseMaxDepthErr(dec.cursor - 1)
+	}
 	// var stringOpen byte = 0
 	for j := dec.cursor; j < dec.length || dec.read(); j++ {
 		switch dec.data[j] {
 		case ']':
 			arraysClosed++
+			nested--
 			// everything is closed return
 			if arraysOpen == arraysClosed {
 				// add char to object data
 				return j + 1, nil
 			}
-		case '[':
-			arraysOpen++
+		case '[', '{':
+			if dec.data[j] == '[' {
+				arraysOpen++
+			}
+			nested++
+			if dec.exceedsDepth(nested) {
+				return 0, dec.raiseMaxDepthErr(j)
+			}
+		case '}':
+			nested--
 		case '"':
 			j++
 			var isInEscapeSeq bool
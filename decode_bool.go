@@ -8,6 +8,9 @@ func (dec *Decoder) DecodeBool(v *bool) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeBool(v)
 }
 func (dec *Decoder) decodeBool(v *bool) error {
@@ -40,8 +43,7 @@ func (dec *Decoder) decodeBool(v *bool) error {
 			*v = false
 			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -85,8 +87,7 @@ func (dec *Decoder) decodeBoolNull(v **bool) error {
 			}
 			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -114,7 +115,7 @@ func (dec *Decoder) assertTrue() error {
 			}
 		case 3:
 			switch dec.data[dec.cursor] {
-			case ' ', '\b', '\t', '\n', ',', ']', '}':
+			case ' ', '\b', '\t', '\n', '\r', ',', ']', '}':
 				// dec.cursor--
 				return nil
 			default:
@@ -147,7 +148,7 @@ func (dec *Decoder) assertNull() error {
 			}
 		case 3:
 			switch dec.data[dec.cursor] {
-			case ' ', '\t', '\n', ',', ']', '}':
+			case ' ', '\t', '\n', '\r', ',', ']', '}':
 				// dec.cursor--
 				return nil
 			default:
@@ -184,7 +185,7 @@ func (dec *Decoder) assertFalse() error {
 			}
 		case 4:
 			switch dec.data[dec.cursor] {
-			case ' ', '\t', '\n', ',', ']', '}':
+			case ' ', '\t', '\n', '\r', ',', ']', '}':
 				// dec.cursor--
 				return nil
 			default:
@@ -239,3 +240,51 @@ func (dec *Decoder) BoolNull(v **bool) error {
 	dec.called |= 1
 	return nil
 }
+
+// AddBoolString decodes the JSON value within an object or an array to a *bool.
+// The value may be a string holding the bool, like `"true"`, see Decoder.BoolString.
+func (dec *Decoder) AddBoolString(v *bool) error {
+	return dec.BoolString(v)
+}
+
+// BoolString decodes the JSON value within an object or an array to a *bool.
+// The value may be a string holding the bool, `"true"` or `"false"`, as encoding/json accepts for fields with the `,string` option.
+// Any other string returns an InvalidUnmarshalError.
+func (dec *Decoder) BoolString(v *bool) error {
+	err := dec.decodeBoolString(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+func (dec *Decoder) decodeBoolString(v *bool) error {
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		switch dec.data[dec.cursor] {
+		case ' ', '\n', '\t', '\r', ',':
+			continue
+		case '"':
+			start := dec.cursor
+			dec.cursor++
+			strStart, strEnd, err := dec.getString()
+			if err != nil {
+				return err
+			}
+			dec.cursor = strEnd
+			// we do minus one to remove the last quote
+			switch string(dec.data[strStart : strEnd-1]) {
+			case "true":
+				*v = true
+			case "false":
+				*v = false
+			default:
+				dec.setInvalidUnmarshalErr(v, start)
+			}
+			return nil
+		default:
+			return dec.decodeBool(v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package gojay
+
+import (
+	"encoding/base64"
+)
+
+// DecodeBytes reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the []byte pointed to by v.
+// The JSON value must be a base64 string using base64.StdEncoding.
+//
+// See the documentation for Unmarshal for details about the conversion of JSON into a Go value.
+func (dec *Decoder) DecodeBytes(v *[]byte) error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
+	return dec.decodeBytes(v, base64.StdEncoding)
+}
+
+// decodeBytes decodes the base64 string straight from the buffer of the Decoder to v.
+func (dec *Decoder) decodeBytes(v *[]byte, encoding *base64.Encoding) error {
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		switch dec.data[dec.cursor] {
+		case ' ', '\n', '\t', '\r', ',':
+			continue
+		case '"':
+			quote := dec.cursor
+			dec.cursor++
+			start, end, err := dec.getString()
+			if err != nil {
+				return err
+			}
+			dec.cursor = end
+			// we do minus one to remove the last quote
+			src := dec.data[start : end-1]
+			b := make([]byte, encoding.DecodedLen(len(src)))
+			n, err := encoding.Decode(b, src)
+			if err != nil {
+				dec.setInvalidUnmarshalErr(v, quote)
+				return nil
+			}
+			*v = b[:n]
+			return nil
+		// is nil
+		case 'n':
+			dec.cursor++
+			return dec.assertNull()
+		default:
+			return dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
+		}
+	}
+	return nil
+}
+
+// Add Values functions
+
+// AddBytes decodes the JSON value within an object or an array to a *[]byte.
+// The JSON value must be a base64 string using base64.StdEncoding.
+// If a `null` is encountered, gojay does not change the value of the slice.
+func (dec *Decoder) AddBytes(v *[]byte) error {
+	return dec.Bytes(v)
+}
+
+// AddBytesEncoding decodes the JSON value within an object or an array to a *[]byte.
+// The JSON value must be a base64 string using the given encoding.
+// If a `null` is encountered, gojay does not change the value of the slice.
+func (dec *Decoder) AddBytesEncoding(v *[]byte, encoding *base64.Encoding) error {
+	return dec.BytesEncoding(v, encoding)
+}
+
+// Bytes decodes the JSON value within an object or an array to a *[]byte.
+// The JSON value must be a base64 string using base64.StdEncoding.
+// If a `null` is encountered, gojay does not change the value of the slice.
+func (dec *Decoder) Bytes(v *[]byte) error {
+	return dec.BytesEncoding(v, base64.StdEncoding)
+}
+
+// BytesEncoding decodes the JSON value within an object or an array to a *[]byte.
+// The JSON value must be a base64 string using the given encoding,
+// for example base64.URLEncoding or base64.RawStdEncoding.
+// If a `null` is encountered, gojay does not change the value of the slice.
+func (dec *Decoder) BytesEncoding(v *[]byte, encoding *base64.Encoding) error {
+	err := dec.decodeBytes(v, encoding)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
@@ -0,0 +1,176 @@
+package gojay
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoderBytes(t *testing.T) {
+	testCases := []struct {
+		name           string
+		json           string
+		expectedResult []byte
+		err            bool
+		errType        interface{}
+	}{
+		{
+			name:           "basic",
+			json:           `"aGVsbG8gd29ybGQ="`,
+			expectedResult: []byte("hello world"),
+		},
+		{
+			name:           "binary",
+			json:           ` "AP8QIA==" `,
+			expectedResult: []byte{0x00, 0xff, 0x10, 0x20},
+		},
+		{
+			name:           "escaped-slash",
+			json:           `"\/\/8="`,
+			expectedResult: []byte{0xff, 0xff},
+		},
+		{
+			name:           "empty",
+			json:           `""`,
+			expectedResult: []byte{},
+		},
+		{
+			name:           "null",
+			json:           `null`,
+			expectedResult: nil,
+		},
+		{
+			name:    "invalid-base64",
+			json:    `"a$b="`,
+			err:     true,
+			errType: InvalidUnmarshalError(""),
+		},
+		{
+			name:    "missing-padding",
+			json:    `"aGk"`,
+			err:     true,
+			errType: InvalidUnmarshalError(""),
+		},
+		{
+			name:    "number",
+			json:    `12`,
+			err:     true,
+			errType: InvalidUnmarshalError(""),
+		},
+		{
+			name:    "invalid-json",
+			json:    `"aGk=`,
+			err:     true,
+			errType: InvalidJSONError(""),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var v []byte
+			err := Unmarshal([]byte(testCase.json), &v)
+			if testCase.err {
+				require.NotNil(t, err, "err should not be nil")
+				assert.IsType(t, testCase.errType, err, "err should be of expected type")
+				return
+			}
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expectedResult, v)
+		})
+	}
+	t.Run("input-not-modified", func(t *testing.T) {
+		var v []byte
+		data := []byte(`"\/w=="`)
+		err := Unmarshal(data, &v)
+		require.Nil(t, err, "err should be nil")
+		assert.Equal(t, []byte{0xff}, v)
+		assert.Equal(t, `"\/w=="`, string(data))
+	})
+	t.Run("decoder", func(t *testing.T) {
+		var v []byte
+		dec := BorrowDecoder(strings.NewReader(`"Z29qYXk="`))
+		defer dec.Release()
+		err := dec.DecodeBytes(&v)
+		require.Nil(t, err, "err should be nil")
+		assert.Equal(t, []byte("gojay"), v)
+	})
+}
+
+type testBytesObject struct {
+	std    []byte
+	url    []byte
+	raw    []byte
+	rawURL []byte
+	list   [][]byte
+}
+
+func (t *testBytesObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "std":
+		return dec.Bytes(&t.std)
+	case "url":
+		return dec.BytesEncoding(&t.url, base64.URLEncoding)
+	case "raw":
+		return dec.AddBytesEncoding(&t.raw, base64.RawStdEncoding)
+	case "rawURL":
+		return dec.BytesEncoding(&t.rawURL, base64.RawURLEncoding)
+	case "list":
+		return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
+			var b []byte
+			if err := dec.AddBytes(&b); err != nil {
+				return err
+			}
+			t.list = append(t.list, b)
+			return nil
+		}))
+	}
+	return nil
+}
+
+func (t *testBytesObject) NKeys() int {
+	return 5
+}
+
+func (t *testBytesObject) MarshalJSONObject(enc *Encoder) {
+	enc.BytesKey("std", t.std)
+	enc.BytesKeyEncoding("url", t.url, base64.URLEncoding)
+	enc.AddBytesKeyEncoding("raw", t.raw, base64.RawStdEncoding)
+	enc.BytesKeyEncoding("rawURL", t.rawURL, base64.RawURLEncoding)
+	enc.ArrayKey("list", EncodeArrayFunc(func(enc *Encoder) {
+		for _, b := range t.list {
+			enc.AddBytes(b)
+		}
+	}))
+}
+
+func (t *testBytesObject) IsNil() bool {
+	return t == nil
+}
+
+func TestDecoderBytesObject(t *testing.T) {
+	json := `{"std":"+/8=","url":"-_8=","raw":"+/8","rawURL":"-_8","list":["AQ==",null,""]}`
+	v := &testBytesObject{}
+	err := UnmarshalJSONObject([]byte(json), v)
+	require.Nil(t, err, "err should be nil")
+	expected := []byte{0xfb, 0xff}
+	assert.Equal(t, expected, v.std)
+	assert.Equal(t, expected, v.url)
+	assert.Equal(t, expected, v.raw)
+	assert.Equal(t, expected, v.rawURL)
+	assert.Equal(t, [][]byte{{0x01}, nil, {}}, v.list)
+
+	b, err := MarshalJSONObject(v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, json, string(b))
+
+	v = &testBytesObject{}
+	err = UnmarshalJSONObjectWithOptions([]byte(`{"std":"-_8=","url":"-_8="}`), v, AllErrors())
+	require.IsType(t, DecodeErrors{}, err, "err should be of type DecodeErrors")
+	errs := err.(DecodeErrors)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "$.std", errs[0].Path)
+	assert.Equal(t, `"-_8="`, errs[0].Token)
+	assert.Equal(t, expected, v.url)
+}
@@ -0,0 +1,79 @@
+package gojay
+
+// CaseInsensitiveKeys returns a DecoderOption matching object keys ignoring ASCII case.
+// See Decoder.UseCaseInsensitiveKeys.
+func CaseInsensitiveKeys() DecoderOption {
+	return func(dec *Decoder) {
+		dec.UseCaseInsensitiveKeys()
+	}
+}
+
+// UseCaseInsensitiveKeys causes the Decoder to match the keys of objects ignoring ASCII case
+// when they are not decoded as they are in the JSON input.
+// If the object implements UnmarshalerKeys, a key is given to UnmarshalJSONObject a second time
+// as it is declared, so that "UserID" and "USERID" match a case written as "userId".
+// Otherwise it is given a second time with its ASCII letters in lower case,
+// so that "userId", "UserID" and "USERID" all match a case written as "userid".
+// Decoders generated by the gojay command implement UnmarshalerKeys.
+// Required keys (see UnmarshalerRequiredKeys) are found ignoring case.
+//
+// Errors and UnknownKeysCollector still get the keys as they are in the JSON input.
+func (dec *Decoder) UseCaseInsensitiveKeys() {
+	dec.caseInsensitiveKeys = true
+}
+
+// unmarshalKey gives the key k to j. If keys are case insensitive and j does not decode k,
+// k is given again as j declares it, or in lower case if j does not declare its keys.
+func (dec *Decoder) unmarshalKey(j UnmarshalerJSONObject, k string) error {
+	err := j.UnmarshalJSONObject(dec, k)
+	if err != nil || !dec.caseInsensitiveKeys || dec.called&1 != 0 {
+		return err
+	}
+	if u, ok := j.(UnmarshalerKeys); ok {
+		for _, key := range u.Keys() {
+			if key != k && equalFoldASCII(key, k) {
+				return j.UnmarshalJSONObject(dec, key)
+			}
+		}
+		return nil
+	}
+	if folded := foldKey(k); folded != k {
+		return j.UnmarshalJSONObject(dec, folded)
+	}
+	return nil
+}
+
+// foldKey returns k with its ASCII letters in lower case.
+// It only allocates if k has upper case letters.
+func foldKey(k string) string {
+	for i := 0; i < len(k); i++ {
+		if 'A' <= k[i] && k[i] <= 'Z' {
+			b := []byte(k)
+			for j := i; j < len(b); j++ {
+				b[j] = toLowerASCII(b[j])
+			}
+			return string(b)
+		}
+	}
+	return k
+}
+
+// equalFoldASCII reports whether a and b are equal ignoring ASCII case.
+func equalFoldASCII(a, b string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if toLowerASCII(a[i]) != toLowerASCII(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func toLowerASCII(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
@@ -0,0 +1,228 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCaseObject switches on lower case keys.
+type testCaseObject struct {
+	userID string
+	child  *testCaseObject
+	extra  UnknownKeys
+	nKeys  int
+}
+
+func (t *testCaseObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "userid":
+		return dec.String(&t.userID)
+	case "child":
+		return dec.ObjectNull(&t.child)
+	}
+	return nil
+}
+
+func (t *testCaseObject) NKeys() int {
+	return t.nKeys
+}
+
+func TestDecoderCaseInsensitiveKeys(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expected string
+	}{
+		{name: "lower", json: `{"userid":"a"}`, expected: "a"},
+		{name: "camel", json: `{"userId":"a"}`, expected: "a"},
+		{name: "upper", json: `{"USERID":"a"}`, expected: "a"},
+		{name: "escaped", json: `{"user\u0049d":"a"}`, expected: "a"},
+		{name: "other-key", json: `{"user_id":"a"}`, expected: ""},
+		{name: "non-ascii", json: `{"userİd":"a"}`, expected: ""},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := &testCaseObject{}
+			err := UnmarshalWithOptions([]byte(testCase.json), v, CaseInsensitiveKeys())
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, v.userID)
+
+			v = &testCaseObject{nKeys: 1}
+			dec := BorrowDecoder(iotest.OneByteReader(strings.NewReader(testCase.json)))
+			defer dec.Release()
+			dec.UseCaseInsensitiveKeys()
+			err = dec.DecodeObject(v)
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, v.userID)
+		})
+	}
+}
+
+func TestDecoderCaseInsensitiveKeysDisabled(t *testing.T) {
+	v := &testCaseObject{}
+	err := UnmarshalJSONObject([]byte(`{"userId":"a"}`), v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "", v.userID)
+
+	dec := BorrowDecoder(nil)
+	dec.UseCaseInsensitiveKeys()
+	dec.Release()
+	dec = BorrowDecoder(strings.NewReader(`{"userId":"a"}`))
+	defer dec.Release()
+	err = dec.DecodeObject(v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "", v.userID, "the option should be reset")
+}
+
+func TestDecoderCaseInsensitiveKeysNested(t *testing.T) {
+	v := &testCaseObject{}
+	err := UnmarshalWithOptions([]byte(`{"Child":{"UserID":"b"},"userId":"a"}`), v, CaseInsensitiveKeys())
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "a", v.userID)
+	require.NotNil(t, v.child)
+	assert.Equal(t, "b", v.child.userID)
+}
+
+func TestDecoderCaseInsensitiveKeysRequired(t *testing.T) {
+	v := &testRequiredKeys{}
+	err := UnmarshalWithOptions([]byte(`{"ID":1,"NAME":"a"}`), v, CaseInsensitiveKeys())
+	assert.Nil(t, err, "required keys should be found ignoring case")
+
+	err = UnmarshalWithOptions([]byte(`{"ID":1}`), &testRequiredKeys{}, CaseInsensitiveKeys())
+	require.IsType(t, &MissingKeysError{}, err, "err should be of type *MissingKeysError")
+	assert.Equal(t, []string{"name"}, err.(*MissingKeysError).Keys)
+}
+
+func TestDecoderCaseInsensitiveKeysOriginalKey(t *testing.T) {
+	v := &testUnknownKeysObject{}
+	err := UnmarshalWithOptions([]byte(`{"Name":"a","ID":1}`), v, CaseInsensitiveKeys())
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "a", v.name)
+	assert.Equal(t, UnknownKeys{"ID": EmbeddedJSON(`1`)}, v.extra, "collected keys should not be folded")
+
+	err = UnmarshalWithOptions([]byte(`{"UserId":"a","Other":1}`), &testCaseObject{}, CaseInsensitiveKeys(), DisallowUnknownFields())
+	require.IsType(t, &UnknownFieldError{}, err, "err should be of type *UnknownFieldError")
+	assert.Equal(t, "Other", err.(*UnknownFieldError).Key)
+}
+
+// testCaseMixedObject switches on mixed case keys, as the decoders generated by the gojay command.
+type testCaseMixedObject struct {
+	userID string
+	name   string
+}
+
+func (t *testCaseMixedObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "userId":
+		return dec.String(&t.userID)
+	case "name":
+		return dec.String(&t.name)
+	}
+	return nil
+}
+
+func (t *testCaseMixedObject) NKeys() int {
+	return 2
+}
+
+func TestDecoderCaseInsensitiveKeysExactMatch(t *testing.T) {
+	v := &testCaseMixedObject{}
+	err := UnmarshalWithOptions([]byte(`{"userId":"a","NAME":"b"}`), v, CaseInsensitiveKeys(), DisallowUnknownFields())
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, &testCaseMixedObject{userID: "a", name: "b"}, v, "keys matching a case exactly should be decoded")
+}
+
+// testCaseDeclaredObject switches on a camelCase key and declares its keys.
+type testCaseDeclaredObject struct {
+	userID int
+}
+
+func (t *testCaseDeclaredObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "userId":
+		return dec.Int(&t.userID)
+	}
+	return nil
+}
+
+func (t *testCaseDeclaredObject) NKeys() int {
+	return 1
+}
+
+func (t *testCaseDeclaredObject) Keys() []string {
+	return []string{"userId"}
+}
+
+func TestDecoderCaseInsensitiveKeysDeclared(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expected int
+	}{
+		{name: "exact", json: `{"userId":1}`, expected: 1},
+		{name: "pascal", json: `{"UserId":2}`, expected: 2},
+		{name: "upper", json: `{"USERID":3}`, expected: 3},
+		{name: "lower", json: `{"userid":4}`, expected: 4},
+		{name: "other-key", json: `{"user_id":5}`, expected: 0},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := &testCaseDeclaredObject{}
+			err := UnmarshalJSONObjectWithOptions([]byte(testCase.json), v, CaseInsensitiveKeys())
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, v.userID)
+
+			v = &testCaseDeclaredObject{}
+			err = UnmarshalJSONObject([]byte(testCase.json), v)
+			require.Nil(t, err, "err should be nil")
+			if testCase.name != "exact" {
+				assert.Equal(t, 0, v.userID, "keys should match exactly without the option")
+			}
+		})
+	}
+	err := UnmarshalJSONObjectWithOptions([]byte(`{"USERID":1}`), &testCaseDeclaredObject{}, CaseInsensitiveKeys(), DisallowUnknownFields())
+	assert.Nil(t, err, "a key matching a declared key should not be unknown")
+}
+
+// testAliasRequiredKeys accepts the required key "id" as "userId" too.
+type testAliasRequiredKeys struct {
+	id int
+}
+
+func (t *testAliasRequiredKeys) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "id", "userId":
+		return dec.Int(&t.id)
+	}
+	return nil
+}
+
+func (t *testAliasRequiredKeys) NKeys() int {
+	return 1
+}
+
+func (t *testAliasRequiredKeys) RequiredKeys() []string {
+	return []string{"id"}
+}
+
+func (t *testAliasRequiredKeys) KeyAliases() map[string]string {
+	return map[string]string{"userId": "id"}
+}
+
+func TestDecoderRequiredKeysAlias(t *testing.T) {
+	v := &testAliasRequiredKeys{}
+	err := UnmarshalJSONObject([]byte(`{"userId":1}`), v)
+	require.Nil(t, err, "required keys should be found under their alias")
+	assert.Equal(t, 1, v.id)
+
+	err = UnmarshalWithOptions([]byte(`{"USERID":2}`), v, CaseInsensitiveKeys())
+	require.Nil(t, err, "aliases should be found ignoring case")
+
+	err = UnmarshalJSONObject([]byte(`{"user":1}`), &testAliasRequiredKeys{})
+	require.IsType(t, &MissingKeysError{}, err, "err should be of type *MissingKeysError")
+	assert.Equal(t, []string{"id"}, err.(*MissingKeysError).Keys)
+}
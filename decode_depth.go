@@ -0,0 +1,43 @@
+package gojay
+
+// DefaultMaxDepth is the maximum nesting depth of objects and arrays accepted by a Decoder by default.
+const DefaultMaxDepth = 10000
+
+// MaxDepth returns a DecoderOption setting the maximum nesting depth.
+// See Decoder.SetMaxDepth.
+func MaxDepth(n int) DecoderOption {
+	return func(dec *Decoder) {
+		dec.SetMaxDepth(n)
+	}
+}
+
+// SetMaxDepth sets the maximum nesting depth of the objects and arrays the Decoder accepts,
+// including the ones skipped because their key is not decoded.
+// A top level object holding an array of numbers has a depth of 2.
+//
+// Decoding values nested deeper returns a *MaxDepthError, which protects the stack
+// against hostile input such as [[[[...]]]]. It is DefaultMaxDepth unless set,
+// a value lower or equal to 0 removes the limit.
+func (dec *Decoder) SetMaxDepth(n int) {
+	dec.maxDepth = n
+}
+
+// incDepth records that the cursor entered an object or an array,
+// the caller must decrement dec.depth when leaving it.
+func (dec *Decoder) incDepth() error {
+	dec.depth++
+	if dec.maxDepth > 0 && dec.depth > dec.maxDepth {
+		return dec.raiseMaxDepthErr(dec.cursor - 1)
+	}
+	return nil
+}
+
+// exceedsDepth reports if n more levels of nesting exceed the maximum depth.
+func (dec *Decoder) exceedsDepth(n int) bool {
+	return dec.maxDepth > 0 && dec.depth+n > dec.maxDepth
+}
+
+func (dec *Decoder) raiseMaxDepthErr(pos int) error {
+	dec.err = dec.decorateErr(&MaxDepthError{MaxDepth: dec.maxDepth}, pos, "")
+	return dec.err
+}
@@ -0,0 +1,211 @@
+package gojay
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDepthObject decodes key "a" to a nested testDepthObject, other keys are skipped.
+type testDepthObject struct {
+	a     *testDepthObject
+	nKeys int
+}
+
+func (t *testDepthObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	if k == "a" {
+		t.a = &testDepthObject{nKeys: t.nKeys}
+		return dec.Object(t.a)
+	}
+	return nil
+}
+
+func (t *testDepthObject) NKeys() int {
+	return t.nKeys
+}
+
+func nestedJSON(open, close string, n int) string {
+	return strings.Repeat(open, n) + strings.Repeat(close, n)
+}
+
+func TestDecoderMaxDepth(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		maxDepth int
+		v        func() interface{}
+		err      bool
+		errType  interface{}
+	}{
+		{
+			name:     "object-at-limit",
+			json:     `{"a":{"a":{}}}`,
+			maxDepth: 3,
+			v:        func() interface{} { return &testDepthObject{} },
+		},
+		{
+			name:     "object-exceeded",
+			json:     `{"a":{"a":{"a":{}}}}`,
+			maxDepth: 3,
+			v:        func() interface{} { return &testDepthObject{} },
+			err:      true,
+		},
+		{
+			name:     "skipped-at-limit",
+			json:     `{"b":[{"c":1}]}`,
+			maxDepth: 3,
+			v:        func() interface{} { return &testDepthObject{} },
+		},
+		{
+			name:     "skipped-exceeded",
+			json:     `{"b":[{"c":[]}]}`,
+			maxDepth: 3,
+			v:        func() interface{} { return &testDepthObject{} },
+			err:      true,
+		},
+		{
+			name:     "skipped-nested-exceeded",
+			json:     `{"a":{"b":[[]]}}`,
+			maxDepth: 3,
+			v:        func() interface{} { return &testDepthObject{} },
+			err:      true,
+		},
+		{
+			name:     "skipped-after-keys-done-at-limit",
+			json:     `{"a":{"a":{},"b":[1]}}`,
+			maxDepth: 3,
+			v:        func() interface{} { return &testDepthObject{nKeys: 1} },
+		},
+		{
+			name:     "skipped-after-keys-done-exceeded",
+			json:     `{"a":{"a":{},"b":[[1]]}}`,
+			maxDepth: 3,
+			v:        func() interface{} { return &testDepthObject{nKeys: 1} },
+			err:      true,
+		},
+		{
+			name:     "type-mismatch-at-limit",
+			json:     `[[1]]`,
+			maxDepth: 2,
+			v:        func() interface{} { return &testDepthObject{} },
+			err:      true,
+			errType:  InvalidUnmarshalError(""),
+		},
+		{
+			name:     "interface-at-limit",
+			json:     `[{"a":[]}]`,
+			maxDepth: 3,
+			v:        func() interface{} { return new(interface{}) },
+		},
+		{
+			name:     "interface-exceeded",
+			json:     `[{"a":[[]]}]`,
+			maxDepth: 3,
+			v:        func() interface{} { return new(interface{}) },
+			err:      true,
+		},
+		{
+			name: "default-limit",
+			json: nestedJSON("[", "]", DefaultMaxDepth+1),
+			v:    func() interface{} { return new(interface{}) },
+			err:  true,
+		},
+		{
+			name: "default-limit-skipped",
+			json: `{"b":` + nestedJSON("[", "]", DefaultMaxDepth) + `}`,
+			v:    func() interface{} { return &testDepthObject{} },
+			err:  true,
+		},
+		{
+			name:     "no-limit",
+			json:     nestedJSON("[", "]", DefaultMaxDepth+1),
+			maxDepth: -1,
+			v:        func() interface{} { return new(interface{}) },
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var opts []DecoderOption
+			if testCase.maxDepth != 0 {
+				opts = append(opts, MaxDepth(testCase.maxDepth))
+			}
+			err := UnmarshalWithOptions([]byte(testCase.json), testCase.v(), opts...)
+			if !testCase.err {
+				require.Nil(t, err, "err should be nil")
+				return
+			}
+			require.NotNil(t, err, "err should not be nil")
+			if testCase.errType != nil {
+				assert.IsType(t, testCase.errType, err, "err should be of expected type")
+				return
+			}
+			require.IsType(t, &MaxDepthError{}, err, "err should be of type *MaxDepthError")
+			expected := testCase.maxDepth
+			if expected == 0 {
+				expected = DefaultMaxDepth
+			}
+			assert.Equal(t, expected, err.(*MaxDepthError).MaxDepth)
+		})
+	}
+}
+
+func TestDecoderMaxDepthStrict(t *testing.T) {
+	err := UnmarshalWithOptions([]byte(`{"a":{"b":[]}}`), &testDepthObject{}, Strict(), MaxDepth(3))
+	assert.Nil(t, err, "err should be nil")
+	err = UnmarshalWithOptions([]byte(`{"a":{"b":[{}]}}`), &testDepthObject{}, Strict(), MaxDepth(3))
+	assert.IsType(t, &MaxDepthError{}, err, "err should be of type *MaxDepthError")
+}
+
+func TestDecoderMaxDepthDetailed(t *testing.T) {
+	err := UnmarshalWithOptions([]byte(`{"a":{"a":{"a":{}}}}`), &testDepthObject{}, MaxDepth(3), DetailedErrors())
+	require.IsType(t, &DecodeError{}, err, "err should be of type *DecodeError")
+	decodeErr := err.(*DecodeError)
+	assert.Equal(t, "$.a.a.a", decodeErr.Path)
+	assert.Equal(t, 15, decodeErr.Offset)
+	var depthErr *MaxDepthError
+	require.True(t, errors.As(err, &depthErr), "err should wrap a *MaxDepthError")
+	assert.Equal(t, 3, depthErr.MaxDepth)
+}
+
+func TestDecoderMaxDepthDecoder(t *testing.T) {
+	t.Run("set-max-depth", func(t *testing.T) {
+		dec := BorrowDecoder(strings.NewReader(`[[1]] [[[1]]]`))
+		defer dec.Release()
+		dec.SetMaxDepth(2)
+		var v interface{}
+		err := dec.Decode(&v)
+		require.Nil(t, err, "err should be nil")
+		err = dec.Decode(&v)
+		assert.IsType(t, &MaxDepthError{}, err, "err should be of type *MaxDepthError")
+	})
+	t.Run("embedded-json", func(t *testing.T) {
+		dec := BorrowDecoder(strings.NewReader(`[[]] [[[]]]`))
+		defer dec.Release()
+		dec.SetMaxDepth(2)
+		var v EmbeddedJSON
+		err := dec.Decode(&v)
+		require.Nil(t, err, "err should be nil")
+		assert.Equal(t, "[[]]", string(v))
+		err = dec.Decode(&EmbeddedJSON{})
+		assert.IsType(t, &MaxDepthError{}, err, "err should be of type *MaxDepthError")
+	})
+	t.Run("depth-restored", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(`{"a":{"a":{}}} {"a":{"a":{}}}`))
+		dec.SetMaxDepth(3)
+		err := dec.Decode(&testDepthObject{})
+		require.Nil(t, err, "err should be nil")
+		err = dec.Decode(&testDepthObject{})
+		require.Nil(t, err, "err should be nil")
+	})
+	t.Run("reset", func(t *testing.T) {
+		dec := BorrowDecoder(nil)
+		dec.SetMaxDepth(1)
+		dec.Release()
+		dec = BorrowDecoder(nil)
+		defer dec.Release()
+		assert.Equal(t, DefaultMaxDepth, dec.maxDepth)
+	})
+}
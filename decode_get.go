@@ -0,0 +1,180 @@
+package gojay
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+)
+
+// ErrPathNotFound is the error returned by Get and its typed variants
+// when the path does not exist in the JSON input.
+var ErrPathNotFound = errors.New("Path not found in JSON input")
+
+// Get returns the raw JSON value found at path in data along with its kind, without decoding the rest of the input.
+// Each element of path is either a key, if the value it applies to is an object,
+// or the index of an element written in decimal without sign nor leading zero, if it is an array.
+// Without path, the top level value is returned.
+//
+// The returned value is a sub slice of data: strings keep their quotes and escape sequences,
+// objects and arrays are returned as they are.
+// If the path does not exist, ErrPathNotFound is returned.
+// Get reads only what is needed to reach the value, the rest of the input is not validated.
+//
+// Example:
+//
+//	v, kind, err := gojay.Get(data, "user", "tags", "0")
+func Get(data []byte, path ...string) ([]byte, Kind, error) {
+	dec := borrowDecoder(nil, 0)
+	defer dec.Release()
+	dec.data = data
+	dec.length = len(data)
+	return dec.get(path)
+}
+
+// GetString returns the string found at path in data, see Get.
+// If the value is not a string nor null, an InvalidUnmarshalError is returned.
+func GetString(data []byte, path ...string) (string, error) {
+	v, _, err := Get(data, path...)
+	if err != nil {
+		return "", err
+	}
+	// escape sequences are decoded in place, so data must not be decoded directly
+	if bytes.IndexByte(v, '\\') != -1 {
+		v = append([]byte(nil), v...)
+	}
+	var s string
+	err = Unmarshal(v, &s)
+	return s, err
+}
+
+// GetInt64 returns the int64 found at path in data, see Get.
+// If the value is not a number nor null, an InvalidUnmarshalError is returned.
+func GetInt64(data []byte, path ...string) (int64, error) {
+	v, _, err := Get(data, path...)
+	if err != nil {
+		return 0, err
+	}
+	var i int64
+	err = Unmarshal(v, &i)
+	return i, err
+}
+
+// GetBool returns the bool found at path in data, see Get.
+// If the value is not a boolean nor null, an InvalidUnmarshalError is returned.
+func GetBool(data []byte, path ...string) (bool, error) {
+	v, _, err := Get(data, path...)
+	if err != nil {
+		return false, err
+	}
+	var b bool
+	err = Unmarshal(v, &b)
+	return b, err
+}
+
+// get moves the cursor along path and returns the value it leads to.
+func (dec *Decoder) get(path []string) ([]byte, Kind, error) {
+	for _, p := range path {
+		switch dec.nextChar() {
+		case '{':
+			dec.cursor++
+			if err := dec.incDepth(); err != nil {
+				return nil, KindInvalid, err
+			}
+			if err := dec.getKey(p); err != nil {
+				return nil, KindInvalid, err
+			}
+		case '[':
+			dec.cursor++
+			if err := dec.incDepth(); err != nil {
+				return nil, KindInvalid, err
+			}
+			if err := dec.getIndex(p); err != nil {
+				return nil, KindInvalid, err
+			}
+		default:
+			if dec.cursor >= dec.length {
+				return nil, KindInvalid, dec.raiseInvalidJSONErr(dec.cursor)
+			}
+			// the path continues into a value which is not an object nor an array
+			return nil, KindInvalid, ErrPathNotFound
+		}
+	}
+	kind := dec.NextKind()
+	start := dec.cursor
+	if err := dec.skipData(); err != nil {
+		return nil, KindInvalid, err
+	}
+	return dec.data[start:dec.cursor], kind, nil
+}
+
+// getKey moves the cursor to the value of key k in the object the cursor is in.
+// Keys are compared without modifying the input, so their escape sequences are decoded on a copy.
+func (dec *Decoder) getKey(k string) error {
+	for {
+		switch dec.nextChar() {
+		case '"':
+			dec.cursor++
+			start := dec.cursor
+			if err := dec.skipString(); err != nil {
+				return err
+			}
+			key := dec.data[start : dec.cursor-1]
+			if !dec.skipColon() {
+				return dec.raiseInvalidJSONErr(dec.cursor)
+			}
+			if string(key) == k {
+				return nil
+			}
+			if bytes.IndexByte(key, '\\') != -1 {
+				var s string
+				quoted := append([]byte(nil), dec.data[start-1:start+len(key)+1]...)
+				if err := Unmarshal(quoted, &s); err == nil && s == k {
+					return nil
+				}
+			}
+			if err := dec.skipData(); err != nil {
+				return err
+			}
+		case '}':
+			return ErrPathNotFound
+		default:
+			return dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+}
+
+// getIndex moves the cursor to the element at index p in the array the cursor is in.
+func (dec *Decoder) getIndex(p string) error {
+	index, ok := parseIndex(p)
+	if !ok {
+		return ErrPathNotFound
+	}
+	for i := 0; ; i++ {
+		switch dec.nextChar() {
+		case ']':
+			return ErrPathNotFound
+		case 0:
+			return dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		if i == index {
+			return nil
+		}
+		if err := dec.skipData(); err != nil {
+			return err
+		}
+	}
+}
+
+// parseIndex parses an array index written in decimal without sign nor leading zero.
+func parseIndex(p string) (int, bool) {
+	if p == "" || len(p) > 1 && p[0] == '0' {
+		return 0, false
+	}
+	for i := 0; i < len(p); i++ {
+		if !isDigit(p[i]) {
+			return 0, false
+		}
+	}
+	index, err := strconv.Atoi(p)
+	return index, err == nil
+}
@@ -0,0 +1,121 @@
+package gojay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGetJSON = `{
+	"type": "message",
+	"id": 42,
+	"ok": true,
+	"user": {"name": "Jay", "tags": ["a", "b\"c"], "address": null},
+	"skipped": {"a": [1, {"b": "}"}], "c": "]"},
+	"esc\u0061ped": "key",
+	"items": [1.5, [2, 3], {"x": "y"}]
+}`
+
+func TestGet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		path     []string
+		expected string
+		kind     Kind
+		err      error
+	}{
+		{name: "string", path: []string{"type"}, expected: `"message"`, kind: KindString},
+		{name: "number", path: []string{"id"}, expected: `42`, kind: KindNumber},
+		{name: "bool", path: []string{"ok"}, expected: `true`, kind: KindBool},
+		{name: "object", path: []string{"user"}, expected: `{"name": "Jay", "tags": ["a", "b\"c"], "address": null}`, kind: KindObject},
+		{name: "nested", path: []string{"user", "name"}, expected: `"Jay"`, kind: KindString},
+		{name: "null", path: []string{"user", "address"}, expected: `null`, kind: KindNull},
+		{name: "array-index", path: []string{"user", "tags", "1"}, expected: `"b\"c"`, kind: KindString},
+		{name: "array", path: []string{"items", "1"}, expected: `[2, 3]`, kind: KindArray},
+		{name: "array-nested", path: []string{"items", "1", "1"}, expected: `3`, kind: KindNumber},
+		{name: "array-object", path: []string{"items", "2", "x"}, expected: `"y"`, kind: KindString},
+		{name: "after-skipped", path: []string{"items", "0"}, expected: `1.5`, kind: KindNumber},
+		{name: "escaped-key", path: []string{"escaped"}, expected: `"key"`, kind: KindString},
+		{name: "no-path", path: nil, expected: testGetJSON, kind: KindObject},
+		{name: "missing-key", path: []string{"missing"}, err: ErrPathNotFound},
+		{name: "missing-nested-key", path: []string{"user", "age"}, err: ErrPathNotFound},
+		{name: "index-out-of-range", path: []string{"items", "3"}, err: ErrPathNotFound},
+		{name: "invalid-index", path: []string{"items", "x"}, err: ErrPathNotFound},
+		{name: "negative-index", path: []string{"items", "-1"}, err: ErrPathNotFound},
+		{name: "into-scalar", path: []string{"id", "x"}, err: ErrPathNotFound},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			data := []byte(testGetJSON)
+			v, kind, err := Get(data, testCase.path...)
+			if testCase.err != nil {
+				assert.Equal(t, testCase.err, err)
+				assert.Equal(t, KindInvalid, kind)
+				return
+			}
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, string(v))
+			assert.Equal(t, testCase.kind, kind)
+			assert.Equal(t, testGetJSON, string(data), "data should not be modified")
+		})
+	}
+}
+
+func TestGetInvalidJSON(t *testing.T) {
+	testCases := []struct {
+		name string
+		json string
+		path []string
+	}{
+		{name: "invalid-key", json: `{a:1}`, path: []string{"a"}},
+		{name: "missing-colon", json: `{"a" 1}`, path: []string{"a"}},
+		{name: "unterminated-object", json: `{"a":1`, path: []string{"b"}},
+		{name: "unterminated-array", json: `[1,2`, path: []string{"3"}},
+		{name: "invalid-value", json: `{"a":x}`, path: []string{"a"}},
+		{name: "empty", json: ``, path: []string{"a"}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, _, err := Get([]byte(testCase.json), testCase.path...)
+			assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+		})
+	}
+}
+
+func TestGetTyped(t *testing.T) {
+	data := []byte(testGetJSON)
+	s, err := GetString(data, "user", "tags", "1")
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, `b"c`, s)
+	assert.Equal(t, testGetJSON, string(data), "data should not be modified")
+
+	s, err = GetString(data, "user", "address")
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "", s)
+
+	_, err = GetString(data, "id")
+	assert.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+
+	i, err := GetInt64(data, "id")
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, int64(42), i)
+
+	_, err = GetInt64(data, "type")
+	assert.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+
+	_, err = GetInt64(data, "missing")
+	assert.Equal(t, ErrPathNotFound, err)
+
+	b, err := GetBool(data, "ok")
+	require.Nil(t, err, "err should be nil")
+	assert.True(t, b)
+
+	_, err = GetBool(data, "items")
+	assert.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+}
+
+func TestGetMaxDepth(t *testing.T) {
+	_, _, err := Get([]byte(nestedJSON("[", "]", DefaultMaxDepth+1)), "0")
+	assert.IsType(t, &MaxDepthError{}, err, "err should be of type *MaxDepthError")
+}
@@ -1,8 +1,10 @@
 package gojay
 
-// TODO @afiune for now we are using the standard json unmarshaling but in
-// the future it would be great to implement one here inside this repo
-import "encoding/json"
+import (
+	"encoding/json"
+	"strconv"
+	"unsafe"
+)
 
 // DecodeInterface reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the value pointed to by i.
 //
@@ -11,105 +13,199 @@ func (dec *Decoder) DecodeInterface(i *interface{}) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	err := dec.decodeInterface(i)
 	return err
 }
 
-func (dec *Decoder) decodeInterface(i *interface{}) error {
-	start, end, err := dec.getObject()
-	if err != nil {
-		dec.cursor = start
-		return err
-	}
-
-	// if start & end are equal the object is a null, don't unmarshal
-	if start == end {
-		return nil
-	}
-
-	object := dec.data[start:end]
-	if err = json.Unmarshal(object, i); err != nil {
-		return err
-	}
-
-	dec.cursor = end
-	return nil
+// UseNumber causes the Decoder to unmarshal a number into an interface{} as a
+// json.Number instead of as a float64.
+func (dec *Decoder) UseNumber() {
+	dec.useNumber = true
 }
 
-// @afiune Maybe return the type as well?
-func (dec *Decoder) getObject() (start int, end int, err error) {
-	// start cursor
+func (dec *Decoder) decodeInterface(i *interface{}) error {
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
 		case ' ', '\n', '\t', '\r', ',':
 			continue
-		// is null
+		// is null, we leave the value untouched
 		case 'n':
 			dec.cursor++
-			err = dec.assertNull()
-			if err != nil {
-				return
-			}
-			// Set start & end to the same cursor to indicate the object
-			// is a null and should not be unmarshal
-			start = dec.cursor
-			end = dec.cursor
-			return
-		case 't':
-			start = dec.cursor
-			dec.cursor++
-			err = dec.assertTrue()
-			if err != nil {
-				return
-			}
-			end = dec.cursor
-			dec.cursor++
-			return
-		// is false
-		case 'f':
-			start = dec.cursor
-			dec.cursor++
-			err = dec.assertFalse()
+			return dec.assertNull()
+		default:
+			v, err := dec.getInterface()
 			if err != nil {
-				return
+				return err
 			}
-			end = dec.cursor
+			*i = v
+			return nil
+		}
+	}
+	return dec.raiseInvalidJSONErr(dec.cursor)
+}
+
+// getInterface decodes the JSON value starting at the cursor to its generic Go representation:
+// map[string]interface{}, []interface{}, string, float64 (or json.Number), bool or nil.
+func (dec *Decoder) getInterface() (interface{}, error) {
+	switch dec.data[dec.cursor] {
+	case '{':
+		dec.cursor++
+		if err := dec.incDepth(); err != nil {
+			return nil, err
+		}
+		m, err := dec.getInterfaceObject()
+		dec.depth--
+		return m, err
+	case '[':
+		dec.cursor++
+		if err := dec.incDepth(); err != nil {
+			return nil, err
+		}
+		s, err := dec.getInterfaceArray()
+		dec.depth--
+		return s, err
+	case '"':
+		dec.cursor++
+		start, end, err := dec.getString()
+		if err != nil {
+			return nil, err
+		}
+		// we do minus one to remove the last quote
+		return string(dec.data[start : end-1]), nil
+	case 't':
+		dec.cursor++
+		if err := dec.assertTrue(); err != nil {
+			return nil, err
+		}
+		return true, nil
+	case 'f':
+		dec.cursor++
+		if err := dec.assertFalse(); err != nil {
+			return nil, err
+		}
+		return false, nil
+	case 'n':
+		dec.cursor++
+		if err := dec.assertNull(); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '-':
+		return dec.getInterfaceNumber()
+	}
+	return nil, dec.raiseInvalidJSONErr(dec.cursor)
+}
+
+func (dec *Decoder) getInterfaceNumber() (interface{}, error) {
+	start := dec.cursor
+	end, err := dec.skipNumber()
+	if err != nil {
+		return nil, err
+	}
+	dec.cursor = end
+	if dec.useNumber {
+		return json.Number(dec.data[start:end]), nil
+	}
+	d := dec.data[start:end]
+	f, err := strconv.ParseFloat(*(*string)(unsafe.Pointer(&d)), 64)
+	if err != nil {
+		return nil, dec.raiseInvalidJSONErr(start)
+	}
+	return f, nil
+}
+
+// getInterfaceObject decodes an object to a map[string]interface{}, the cursor must be right after the opening brace.
+// Unlike the lenient scanning of decodeObject, keys and values must be properly separated
+// as the values are not checked against any schema.
+func (dec *Decoder) getInterfaceObject() (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	if dec.skipSpaces() == '}' {
+		dec.cursor++
+		return m, nil
+	}
+	for n := 1; ; n++ {
+		if dec.skipSpaces() != '"' {
+			return nil, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		if exceedsLimit(dec.maxObjectKeys, n) {
+			return nil, dec.raiseLimitErr(LimitObjectKeys, dec.maxObjectKeys, dec.cursor)
+		}
+		dec.cursor++
+		start, end, err := dec.getString()
+		if err != nil {
+			return nil, err
+		}
+		// key must be copied as the buffer is reused
+		k := string(dec.data[start : end-1])
+		if dec.skipSpaces() != ':' {
+			return nil, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		dec.cursor++
+		if dec.skipSpaces() == 0 {
+			return nil, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		v, err := dec.getInterface()
+		if err != nil {
+			return nil, err
+		}
+		m[k] = v
+		switch dec.skipSpaces() {
+		case ',':
 			dec.cursor++
-			return
-		// is an object
-		case '{':
-			start = dec.cursor
+		case '}':
 			dec.cursor++
-			end, err = dec.skipObject()
-			dec.cursor = end
-			return
-		// is string
-		case '"':
-			start = dec.cursor
+			return m, nil
+		default:
+			return nil, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+}
+
+// getInterfaceArray decodes an array to a []interface{}, the cursor must be right after the opening bracket.
+func (dec *Decoder) getInterfaceArray() ([]interface{}, error) {
+	s := make([]interface{}, 0)
+	if dec.skipSpaces() == ']' {
+		dec.cursor++
+		return s, nil
+	}
+	for {
+		if dec.skipSpaces() == 0 {
+			return nil, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		if exceedsLimit(dec.maxArrayLength, len(s)+1) {
+			return nil, dec.raiseLimitErr(LimitArrayLength, dec.maxArrayLength, dec.cursor)
+		}
+		v, err := dec.getInterface()
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, v)
+		switch dec.skipSpaces() {
+		case ',':
 			dec.cursor++
-			start, end, err = dec.getString()
-			start--
-			dec.cursor = end
-			return
-		// is array
-		case '[':
-			start = dec.cursor
+		case ']':
 			dec.cursor++
-			end, err = dec.skipArray()
-			dec.cursor = end
-			return
-		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '-':
-			start = dec.cursor
-			end, err = dec.skipNumber()
-			dec.cursor = end
-			return
+			return s, nil
 		default:
-			err = dec.raiseInvalidJSONErr(dec.cursor)
-			return
+			return nil, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+}
+
+// skipSpaces moves the cursor to the next non white space char and returns it.
+// Contrary to nextChar, commas are not skipped. It returns 0 if the end of the input is reached.
+func (dec *Decoder) skipSpaces() byte {
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		switch dec.data[dec.cursor] {
+		case ' ', '\n', '\t', '\r':
+			continue
 		}
+		return dec.data[dec.cursor]
 	}
-	err = dec.raiseInvalidJSONErr(dec.cursor)
-	return
+	return 0
 }
 
 // Add Values functions
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -63,14 +64,14 @@ func TestDecodeInterfaceBasic(t *testing.T) {
 			name:            "array-error",
 			json:            `["h""o","l","a"]`,
 			err:             true,
-			errType:         &json.SyntaxError{},
+			errType:         InvalidJSONError(""),
 			skipCheckResult: true,
 		},
 		{
 			name:            "object-error",
 			json:            `{"testStr" "hello world!"}`,
 			err:             true,
-			errType:         &json.SyntaxError{},
+			errType:         InvalidJSONError(""),
 			skipCheckResult: true,
 		},
 		{
@@ -207,7 +208,7 @@ func TestDecodeInterfaceAsInterface(t *testing.T) {
         "testInterface": ["a""d","i","o","s"]
       }`,
 			err:             true,
-			errType:         &json.SyntaxError{},
+			errType:         InvalidJSONError(""),
 			skipCheckResult: true,
 		},
 		{
@@ -340,7 +341,7 @@ func TestDecodeAsTestObject(t *testing.T) {
         "testInterface": ["a""d","i","o","s"]
       }`,
 			err:             true,
-			errType:         &json.SyntaxError{},
+			errType:         InvalidJSONError(""),
 			skipCheckResult: true,
 		},
 		{
@@ -503,7 +504,7 @@ func TestUnmarshalInterfaceError(t *testing.T) {
 			err := Unmarshal(testCase.json, v)
 			assert.NotNil(t, err, "Err must be not nil")
 			t.Log(err)
-			assert.IsType(t, &json.SyntaxError{}, err, "err should be a json.SyntaxError{}")
+			assert.IsType(t, InvalidJSONError(""), err, "err should be an InvalidJSONError")
 		})
 	}
 }
@@ -529,3 +530,79 @@ func TestDecodeNull(t *testing.T) {
 	assert.Nil(t, err, "err should be nil")
 	assert.Equal(t, interface{}(nil), i, "value at given index should be the same as expected results")
 }
+
+func TestDecodeInterfaceNative(t *testing.T) {
+	testCases := []struct {
+		name           string
+		json           string
+		useNumber      bool
+		expectedResult interface{}
+		err            bool
+	}{
+		{
+			name: "nested",
+			json: `{"a":[1,{"b":null},[]],"c":{},"d":-1.5e2,"e":"é\"\\"}`,
+			expectedResult: map[string]interface{}{
+				"a": []interface{}{float64(1), map[string]interface{}{"b": nil}, []interface{}{}},
+				"c": map[string]interface{}{},
+				"d": float64(-150),
+				"e": "é\"\\",
+			},
+		},
+		{
+			name:           "escaped-key",
+			json:           `{"k\"ey":true}`,
+			expectedResult: map[string]interface{}{`k"ey`: true},
+		},
+		{
+			name: "out-of-range-number",
+			json: `[1e400]`,
+			err:  true,
+		},
+		{
+			name:           "use-number",
+			json:           `{"a":1.10,"b":[-0,9007199254740993]}`,
+			useNumber:      true,
+			expectedResult: map[string]interface{}{"a": json.Number("1.10"), "b": []interface{}{json.Number("-0"), json.Number("9007199254740993")}},
+		},
+		{
+			name: "missing-comma-object",
+			json: `{"a":1 "b":2}`,
+			err:  true,
+		},
+		{
+			name: "trailing-comma-array",
+			json: `[1,2,]`,
+			err:  true,
+		},
+		{
+			name: "unclosed-array",
+			json: `[1,2`,
+			err:  true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var i interface{}
+			// read byte by byte to make sure values spanning multiple reads are decoded
+			dec := NewDecoder(iotest.OneByteReader(strings.NewReader(testCase.json)))
+			if testCase.useNumber {
+				dec.UseNumber()
+			}
+			err := dec.DecodeInterface(&i)
+			if testCase.err {
+				assert.NotNil(t, err, "err should not be nil")
+				return
+			}
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expectedResult, i, "value should be the same as expected results")
+		})
+	}
+}
+
+func TestDecodeInterfaceLargeNumber(t *testing.T) {
+	var i interface{}
+	err := Unmarshal([]byte(`[12345678901234567890]`), &i)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, []interface{}{float64(12345678901234567890)}, i, "value should be the same as expected results")
+}
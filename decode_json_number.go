@@ -0,0 +1,100 @@
+package gojay
+
+import (
+	"strconv"
+)
+
+// Number is the literal of a JSON number.
+// It keeps the digits as they are in the JSON input, so that a number can be
+// decoded and encoded again without losing precision or changing its formatting.
+type Number string
+
+// String returns the literal of the number.
+func (n Number) String() string {
+	return string(n)
+}
+
+// Int64 returns the number as an int64.
+func (n Number) Int64() (int64, error) {
+	return strconv.ParseInt(string(n), 10, 64)
+}
+
+// Uint64 returns the number as an uint64.
+func (n Number) Uint64() (uint64, error) {
+	return strconv.ParseUint(string(n), 10, 64)
+}
+
+// Float64 returns the number as a float64.
+func (n Number) Float64() (float64, error) {
+	return strconv.ParseFloat(string(n), 64)
+}
+
+// DecodeNumber reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the Number pointed to by v.
+//
+// See the documentation for Unmarshal for details about the conversion of JSON into a Go value.
+func (dec *Decoder) DecodeNumber(v *Number) error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
+	return dec.decodeNumber(v)
+}
+func (dec *Decoder) decodeNumber(v *Number) error {
+	lit, _, err := dec.getNumberLiteral(v)
+	if err != nil || lit == nil {
+		return err
+	}
+	*v = Number(lit)
+	return nil
+}
+func (dec *Decoder) decodeNumberNull(v **Number) error {
+	lit, _, err := dec.getNumberLiteral(v)
+	if err != nil || lit == nil {
+		return err
+	}
+	if *v == nil {
+		*v = new(Number)
+	}
+	**v = Number(lit)
+	return nil
+}
+
+// Add Values functions
+
+// AddNumber decodes the JSON value within an object or an array to a *Number.
+// The JSON value can be a number or a string holding a number.
+func (dec *Decoder) AddNumber(v *Number) error {
+	return dec.Number(v)
+}
+
+// AddNumberNull decodes the JSON value within an object or an array to a **Number.
+// The JSON value can be a number or a string holding a number.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) AddNumberNull(v **Number) error {
+	return dec.NumberNull(v)
+}
+
+// Number decodes the JSON value within an object or an array to a *Number.
+// The JSON value can be a number or a string holding a number.
+func (dec *Decoder) Number(v *Number) error {
+	err := dec.decodeNumber(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// NumberNull decodes the JSON value within an object or an array to a **Number.
+// The JSON value can be a number or a string holding a number.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) NumberNull(v **Number) error {
+	err := dec.decodeNumberNull(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
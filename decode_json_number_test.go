@@ -0,0 +1,186 @@
+package gojay
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoderNumber(t *testing.T) {
+	testCases := []struct {
+		name           string
+		json           string
+		expectedResult Number
+		err            bool
+		errType        interface{}
+	}{
+		{
+			name:           "trailing-zero",
+			json:           "1.10",
+			expectedResult: "1.10",
+		},
+		{
+			name:           "negative-exponent",
+			json:           " -12.5E+03 ",
+			expectedResult: "-12.5E+03",
+		},
+		{
+			name:           "zero",
+			json:           "0",
+			expectedResult: "0",
+		},
+		{
+			name:           "large",
+			json:           "123456789012345678901234567890.000000000000000000001",
+			expectedResult: "123456789012345678901234567890.000000000000000000001",
+		},
+		{
+			name:           "quoted",
+			json:           `"100.00"`,
+			expectedResult: "100.00",
+		},
+		{
+			name:           "null",
+			json:           "null",
+			expectedResult: "",
+		},
+		{
+			name:    "string",
+			json:    `"1.1.1"`,
+			err:     true,
+			errType: InvalidUnmarshalError(""),
+		},
+		{
+			name:    "array",
+			json:    `[1]`,
+			err:     true,
+			errType: InvalidUnmarshalError(""),
+		},
+		{
+			name:    "invalid-json",
+			json:    `-`,
+			err:     true,
+			errType: InvalidJSONError(""),
+		},
+		{
+			name:    "invalid-json-fraction",
+			json:    `1.`,
+			err:     true,
+			errType: InvalidJSONError(""),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var v Number
+			err := Unmarshal([]byte(testCase.json), &v)
+			if testCase.err {
+				require.NotNil(t, err, "err should not be nil")
+				assert.IsType(t, testCase.errType, err, "err should be of expected type")
+				return
+			}
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expectedResult, v)
+		})
+	}
+	t.Run("decoder", func(t *testing.T) {
+		var v Number
+		dec := BorrowDecoder(strings.NewReader("3.140"))
+		defer dec.Release()
+		err := dec.DecodeNumber(&v)
+		require.Nil(t, err, "err should be nil")
+		assert.Equal(t, Number("3.140"), v)
+	})
+	t.Run("decoder-null", func(t *testing.T) {
+		var v *Number
+		err := Unmarshal([]byte("null"), &v)
+		require.Nil(t, err, "err should be nil")
+		assert.Nil(t, v, "v should be nil")
+		err = Unmarshal([]byte("1e3"), &v)
+		require.Nil(t, err, "err should be nil")
+		require.NotNil(t, v, "v should not be nil")
+		assert.Equal(t, Number("1e3"), *v)
+	})
+}
+
+func TestNumberAccessors(t *testing.T) {
+	i, err := Number("-42").Int64()
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, int64(-42), i)
+	_, err = Number("1.5").Int64()
+	assert.NotNil(t, err, "err should not be nil")
+
+	u, err := Number("18446744073709551615").Uint64()
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, uint64(math.MaxUint64), u)
+	_, err = Number("-1").Uint64()
+	assert.NotNil(t, err, "err should not be nil")
+
+	f, err := Number("1.10").Float64()
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, 1.1, f)
+	assert.Equal(t, "1.10", Number("1.10").String())
+}
+
+type testNumberObject struct {
+	amount Number
+	fee    *Number
+	rates  []Number
+}
+
+func (t *testNumberObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "amount":
+		return dec.Number(&t.amount)
+	case "fee":
+		return dec.AddNumberNull(&t.fee)
+	case "rates":
+		return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
+			var n Number
+			if err := dec.AddNumber(&n); err != nil {
+				return err
+			}
+			t.rates = append(t.rates, n)
+			return nil
+		}))
+	}
+	return nil
+}
+
+func (t *testNumberObject) NKeys() int {
+	return 3
+}
+
+func (t *testNumberObject) MarshalJSONObject(enc *Encoder) {
+	enc.NumberKey("amount", t.amount)
+	if t.fee != nil {
+		enc.NumberKey("fee", *t.fee)
+	}
+	enc.ArrayKey("rates", EncodeArrayFunc(func(enc *Encoder) {
+		for _, n := range t.rates {
+			enc.Number(n)
+		}
+	}))
+}
+
+func (t *testNumberObject) IsNil() bool {
+	return t == nil
+}
+
+func TestDecoderNumberObject(t *testing.T) {
+	json := `{"amount":1.10,"fee":0.00,"rates":[1.000,2e10,-0.5]}`
+	v := &testNumberObject{}
+	err := UnmarshalJSONObject([]byte(json), v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, Number("1.10"), v.amount)
+	require.NotNil(t, v.fee, "v.fee should not be nil")
+	assert.Equal(t, Number("0.00"), *v.fee)
+	assert.Equal(t, []Number{"1.000", "2e10", "-0.5"}, v.rates)
+
+	// the digits are kept as they are
+	b, err := MarshalJSONObject(v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, json, string(b))
+}
@@ -0,0 +1,50 @@
+package gojay
+
+// Kind is the kind of a JSON value, as returned by NextKind and Get.
+// It is the TokenKind of the first token of the value.
+type Kind = TokenKind
+
+const (
+	// KindInvalid is returned when there is no valid value, like at the end of the input.
+	KindInvalid = TokenInvalid
+	// KindObject is the kind of JSON objects.
+	KindObject = TokenObjectStart
+	// KindArray is the kind of JSON arrays.
+	KindArray = TokenArrayStart
+	// KindString is the kind of JSON strings.
+	KindString = TokenString
+	// KindNumber is the kind of JSON numbers.
+	KindNumber = TokenNumber
+	// KindBool is the kind of `true` and `false`.
+	KindBool = TokenBool
+	// KindNull is the kind of `null`.
+	KindNull = TokenNull
+)
+
+// NextKind returns the kind of the next value without consuming it, reading from the io.Reader if needed.
+// It is KindInvalid at the end of the input or if the next char cannot start a value.
+// Unlike PeekKind, it never records an error.
+//
+// Within UnmarshalJSONObject or UnmarshalJSONArray, it allows choosing the method decoding a value
+// which can be of several types:
+//
+//	func (u *user) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+//		if k == "address" {
+//			if dec.NextKind() == gojay.KindString {
+//				return dec.String(&u.addressLine)
+//			}
+//			return dec.Object(&u.address)
+//		}
+//		return nil
+//	}
+func (dec *Decoder) NextKind() Kind {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	switch kind := tokenKindOf(dec.nextChar()); kind {
+	case TokenObjectEnd, TokenArrayEnd:
+		return KindInvalid
+	default:
+		return kind
+	}
+}
@@ -0,0 +1,158 @@
+package gojay
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testNextKindObject decodes key "v" according to its kind.
+type testNextKindObject struct {
+	kind Kind
+	str  string
+	num  float64
+	obj  *testNextKindObject
+	arr  testSliceInts
+	b    bool
+}
+
+func (t *testNextKindObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	if k != "v" {
+		return nil
+	}
+	t.kind = dec.NextKind()
+	switch t.kind {
+	case KindString:
+		return dec.String(&t.str)
+	case KindNumber:
+		return dec.Float64(&t.num)
+	case KindObject:
+		t.obj = &testNextKindObject{}
+		return dec.Object(t.obj)
+	case KindArray:
+		return dec.Array(&t.arr)
+	case KindBool:
+		return dec.Bool(&t.b)
+	}
+	return nil
+}
+
+func (t *testNextKindObject) NKeys() int {
+	return 0
+}
+
+func TestDecoderNextKind(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expected testNextKindObject
+	}{
+		{
+			name:     "string",
+			json:     `{"v" : "abc"}`,
+			expected: testNextKindObject{kind: KindString, str: "abc"},
+		},
+		{
+			name:     "number",
+			json:     `{"v":-1.5}`,
+			expected: testNextKindObject{kind: KindNumber, num: -1.5},
+		},
+		{
+			name:     "object",
+			json:     `{"v":  {"v":"a"}}`,
+			expected: testNextKindObject{kind: KindObject, obj: &testNextKindObject{kind: KindString, str: "a"}},
+		},
+		{
+			name:     "array",
+			json:     `{"v":[1,2]}`,
+			expected: testNextKindObject{kind: KindArray, arr: testSliceInts{1, 2}},
+		},
+		{
+			name:     "bool",
+			json:     `{"v":true}`,
+			expected: testNextKindObject{kind: KindBool, b: true},
+		},
+		{
+			name:     "null",
+			json:     `{"v":null}`,
+			expected: testNextKindObject{kind: KindNull},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := testNextKindObject{}
+			err := UnmarshalJSONObject([]byte(testCase.json), &v)
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, v)
+
+			v = testNextKindObject{}
+			dec := NewDecoder(iotest.OneByteReader(strings.NewReader(testCase.json)))
+			err = dec.DecodeObject(&v)
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, v)
+		})
+	}
+}
+
+func TestDecoderNextKindTopLevel(t *testing.T) {
+	testCases := []struct {
+		json     string
+		expected Kind
+	}{
+		{json: `  {}`, expected: KindObject},
+		{json: "\n[]", expected: KindArray},
+		{json: `"a"`, expected: KindString},
+		{json: `0`, expected: KindNumber},
+		{json: `false`, expected: KindBool},
+		{json: `null`, expected: KindNull},
+		{json: `  `, expected: KindInvalid},
+		{json: `x`, expected: KindInvalid},
+		{json: `]`, expected: KindInvalid},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.json, func(t *testing.T) {
+			dec := BorrowDecoder(iotest.OneByteReader(strings.NewReader(testCase.json)))
+			defer dec.Release()
+			assert.Equal(t, testCase.expected, dec.NextKind())
+			// the value is not consumed
+			assert.Equal(t, testCase.expected, dec.NextKind())
+			if testCase.expected != KindInvalid {
+				var v interface{}
+				err := dec.Decode(&v)
+				assert.Nil(t, err, "err should be nil")
+				assert.Equal(t, KindInvalid, dec.NextKind())
+			}
+			assert.Nil(t, dec.err, "NextKind should not record an error")
+		})
+	}
+}
+
+func TestDecoderNextKindStream(t *testing.T) {
+	dec := NewDecoder(io.MultiReader(strings.NewReader(`"a" `), strings.NewReader(`{"v":1}`)))
+	require.Equal(t, KindString, dec.NextKind())
+	var s string
+	require.Nil(t, dec.Decode(&s), "err should be nil")
+	require.Equal(t, KindObject, dec.NextKind())
+	v := testNextKindObject{}
+	require.Nil(t, dec.Decode(&v), "err should be nil")
+	assert.Equal(t, testNextKindObject{kind: KindNumber, num: 1}, v)
+}
+
+func TestDecoderNextKindPeekKind(t *testing.T) {
+	dec := BorrowDecoder(strings.NewReader(`[1]`))
+	defer dec.Release()
+	k, err := dec.PeekKind()
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, k, dec.NextKind(), "NextKind and PeekKind should return the same kind")
+	assert.Equal(t, "array start", KindArray.String())
+}
+
+func TestDecoderNextKindPoolError(t *testing.T) {
+	dec := BorrowDecoder(nil)
+	dec.Release()
+	assert.Panics(t, func() { _ = dec.NextKind() }, "NextKind should panic on a released decoder")
+}
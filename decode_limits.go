@@ -0,0 +1,209 @@
+package gojay
+
+// Limit is the name of a limit of the Decoder, it is given by a LimitError.
+type Limit string
+
+const (
+	// LimitBytes is the limit set by Decoder.SetMaxBytes.
+	LimitBytes Limit = "input size"
+	// LimitStringLength is the limit set by Decoder.SetMaxStringLength.
+	LimitStringLength Limit = "string length"
+	// LimitArrayLength is the limit set by Decoder.SetMaxArrayLength.
+	LimitArrayLength Limit = "array length"
+	// LimitObjectKeys is the limit set by Decoder.SetMaxObjectKeys.
+	LimitObjectKeys Limit = "object key count"
+)
+
+// MaxBytes returns a DecoderOption setting the maximum size of the input.
+// See Decoder.SetMaxBytes.
+func MaxBytes(n int) DecoderOption {
+	return func(dec *Decoder) {
+		dec.SetMaxBytes(n)
+	}
+}
+
+// SetMaxBytes sets the maximum number of bytes the Decoder reads from its input during its lifetime,
+// including the values already decoded by a StreamDecoder.
+// The io.Reader is never read more than one byte past the limit, so that the buffer of the Decoder stays bounded.
+//
+// Decoding fails with a *LimitError as soon as the input is found to exceed the limit,
+// values still in the buffer are not decoded.
+// A value lower or equal to 0 removes the limit, which is the default.
+func (dec *Decoder) SetMaxBytes(n int) {
+	dec.maxBytes = n
+}
+
+// MaxStringLength returns a DecoderOption setting the maximum length of strings.
+// See Decoder.SetMaxStringLength.
+func MaxStringLength(n int) DecoderOption {
+	return func(dec *Decoder) {
+		dec.SetMaxStringLength(n)
+	}
+}
+
+// SetMaxStringLength sets the maximum length in bytes of the strings and keys the Decoder decodes,
+// including those of skipped values and EmbeddedJSON.
+//
+// Exceeding it returns a *LimitError. A value lower or equal to 0 removes the limit, which is the default.
+func (dec *Decoder) SetMaxStringLength(n int) {
+	dec.maxStringLength = n
+}
+
+// MaxArrayLength returns a DecoderOption setting the maximum number of elements of arrays.
+// See Decoder.SetMaxArrayLength.
+func MaxArrayLength(n int) DecoderOption {
+	return func(dec *Decoder) {
+		dec.SetMaxArrayLength(n)
+	}
+}
+
+// SetMaxArrayLength sets the maximum number of elements of the arrays the Decoder decodes,
+// including skipped values and EmbeddedJSON.
+//
+// Exceeding it returns a *LimitError. A value lower or equal to 0 removes the limit, which is the default.
+func (dec *Decoder) SetMaxArrayLength(n int) {
+	dec.maxArrayLength = n
+}
+
+// MaxObjectKeys returns a DecoderOption setting the maximum number of keys of objects.
+// See Decoder.SetMaxObjectKeys.
+func MaxObjectKeys(n int) DecoderOption {
+	return func(dec *Decoder) {
+		dec.SetMaxObjectKeys(n)
+	}
+}
+
+// SetMaxObjectKeys sets the maximum number of keys of the objects the Decoder decodes,
+// including skipped values and EmbeddedJSON.
+//
+// Exceeding it returns a *LimitError. A value lower or equal to 0 removes the limit, which is the default.
+func (dec *Decoder) SetMaxObjectKeys(n int) {
+	dec.maxObjectKeys = n
+}
+
+// exceedsLimit reports if n exceeds the limit max, a max lower or equal to 0 being no limit.
+func exceedsLimit(max, n int) bool {
+	return max > 0 && n > max
+}
+
+// checkMaxBytes records a LimitError and returns false if more bytes than allowed were read.
+// Once recorded, the error is returned instead of the syntax error caused by the input being cut.
+func (dec *Decoder) checkMaxBytes() bool {
+	if !exceedsLimit(dec.maxBytes, dec.offset+dec.length) {
+		return true
+	}
+	dec.limitErr = dec.raiseLimitErr(LimitBytes, dec.maxBytes, dec.maxBytes-dec.offset)
+	return false
+}
+
+func (dec *Decoder) raiseLimitErr(limit Limit, max int, pos int) error {
+	dec.err = dec.decorateErr(&LimitError{Limit: limit, Max: max}, pos, "")
+	return dec.err
+}
+
+// hasElementLimits reports if the length of strings, arrays or objects is limited.
+// Skipped values are then read by the functions below, which count their elements
+// and are slower than skipObject, skipArray and skipString.
+func (dec *Decoder) hasElementLimits() bool {
+	return dec.maxStringLength > 0 || dec.maxArrayLength > 0 || dec.maxObjectKeys > 0
+}
+
+// skipObjectLimited is skipObject enforcing the limits of the Decoder,
+// the cursor being after the opening brace or after the value of the n-th key.
+func (dec *Decoder) skipObjectLimited(n int) (int, error) {
+	if err := dec.incDepth(); err != nil {
+		return 0, err
+	}
+	err := dec.skipObjectKeys(n)
+	dec.depth--
+	if err != nil {
+		return 0, err
+	}
+	return dec.cursor, nil
+}
+
+func (dec *Decoder) skipObjectKeys(n int) error {
+	for {
+		switch dec.nextChar() {
+		case '}':
+			dec.cursor++
+			return nil
+		case '"':
+			if n++; exceedsLimit(dec.maxObjectKeys, n) {
+				return dec.raiseLimitErr(LimitObjectKeys, dec.maxObjectKeys, dec.cursor)
+			}
+			dec.cursor++
+			if err := dec.skipStringLimited(); err != nil {
+				return err
+			}
+			if dec.skipSpaces() != ':' {
+				return dec.raiseInvalidJSONErr(dec.cursor)
+			}
+			dec.cursor++
+			if err := dec.skipData(); err != nil {
+				return err
+			}
+		default:
+			return dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+}
+
+// skipArrayLimited is skipArray enforcing the limits of the Decoder.
+func (dec *Decoder) skipArrayLimited() (int, error) {
+	if err := dec.incDepth(); err != nil {
+		return 0, err
+	}
+	err := dec.skipArrayElements()
+	dec.depth--
+	if err != nil {
+		return 0, err
+	}
+	return dec.cursor, nil
+}
+
+func (dec *Decoder) skipArrayElements() error {
+	for n := 1; ; n++ {
+		switch dec.nextChar() {
+		case ']':
+			dec.cursor++
+			return nil
+		case 0:
+			return dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		if exceedsLimit(dec.maxArrayLength, n) {
+			return dec.raiseLimitErr(LimitArrayLength, dec.maxArrayLength, dec.cursor)
+		}
+		if err := dec.skipData(); err != nil {
+			return err
+		}
+	}
+}
+
+// skipStringLimited is skipString enforcing the maximum length of strings.
+func (dec *Decoder) skipStringLimited() error {
+	start := dec.cursor
+	// n counts escape sequences as one byte, as assertString
+	var n int
+	for dec.cursor < dec.length || dec.read() {
+		c := dec.data[dec.cursor]
+		dec.cursor++
+		switch c {
+		case '"':
+			return nil
+		case '\\':
+			// the escaped char, and the hex digits of a \u escape
+			skip := 1
+			if (dec.cursor < dec.length || dec.read()) && dec.data[dec.cursor] == 'u' {
+				skip = 5
+			}
+			for ; skip > 0 && (dec.cursor < dec.length || dec.read()); skip-- {
+				dec.cursor++
+			}
+		}
+		if n++; exceedsLimit(dec.maxStringLength, n) {
+			return dec.raiseLimitErr(LimitStringLength, dec.maxStringLength, start-1)
+		}
+	}
+	return dec.raiseInvalidJSONErr(dec.cursor)
+}
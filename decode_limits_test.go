@@ -0,0 +1,375 @@
+package gojay
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// endlessReader returns an array which never ends and counts the bytes read.
+type endlessReader struct {
+	n int
+}
+
+func (r *endlessReader) Read(b []byte) (int, error) {
+	for i := range b {
+		if r.n+i == 0 {
+			b[i] = '['
+		} else if (r.n+i)%2 == 1 {
+			b[i] = '1'
+		} else {
+			b[i] = ','
+		}
+	}
+	r.n += len(b)
+	return len(b), nil
+}
+
+func TestDecoderLimits(t *testing.T) {
+	testCases := []struct {
+		name  string
+		json  string
+		opt   DecoderOption
+		v     func() interface{}
+		limit Limit
+	}{
+		{
+			name: "bytes-at-limit",
+			json: `[1,2]`,
+			opt:  MaxBytes(5),
+			v:    func() interface{} { return new(interface{}) },
+		},
+		{
+			name:  "bytes-exceeded",
+			json:  `[1,2] `,
+			opt:   MaxBytes(5),
+			v:     func() interface{} { return new(interface{}) },
+			limit: LimitBytes,
+		},
+		{
+			name:  "bytes-exceeded-number",
+			json:  `1234`,
+			opt:   MaxBytes(3),
+			v:     func() interface{} { return new(int) },
+			limit: LimitBytes,
+		},
+		{
+			name: "string-at-limit",
+			json: `"abc"`,
+			opt:  MaxStringLength(3),
+			v:    func() interface{} { return new(string) },
+		},
+		{
+			name: "string-escaped-at-limit",
+			json: `"a\nb"`,
+			opt:  MaxStringLength(3),
+			v:    func() interface{} { return new(string) },
+		},
+		{
+			name:  "string-exceeded",
+			json:  `"abcd"`,
+			opt:   MaxStringLength(3),
+			v:     func() interface{} { return new(string) },
+			limit: LimitStringLength,
+		},
+		{
+			name:  "string-escaped-exceeded",
+			json:  `"ab\nc"`,
+			opt:   MaxStringLength(3),
+			v:     func() interface{} { return new(string) },
+			limit: LimitStringLength,
+		},
+		{
+			name:  "string-key-exceeded",
+			json:  `{"abcd":1}`,
+			opt:   MaxStringLength(3),
+			v:     func() interface{} { return &testDepthObject{} },
+			limit: LimitStringLength,
+		},
+		{
+			name:  "string-interface-exceeded",
+			json:  `["abc","abcd"]`,
+			opt:   MaxStringLength(3),
+			v:     func() interface{} { return new(interface{}) },
+			limit: LimitStringLength,
+		},
+		{
+			name: "array-at-limit",
+			json: `[1,2,3]`,
+			opt:  MaxArrayLength(3),
+			v:    func() interface{} { return &testSliceInts{} },
+		},
+		{
+			name:  "array-exceeded",
+			json:  `[1,2,3,4]`,
+			opt:   MaxArrayLength(3),
+			v:     func() interface{} { return &testSliceInts{} },
+			limit: LimitArrayLength,
+		},
+		{
+			name:  "array-interface-exceeded",
+			json:  `{"a":[1,2,3,4]}`,
+			opt:   MaxArrayLength(3),
+			v:     func() interface{} { return new(interface{}) },
+			limit: LimitArrayLength,
+		},
+		{
+			name: "object-at-limit",
+			json: `{"a":{},"b":1}`,
+			opt:  MaxObjectKeys(2),
+			v:    func() interface{} { return &testDepthObject{} },
+		},
+		{
+			name:  "object-exceeded",
+			json:  `{"a":{},"b":1,"c":2}`,
+			opt:   MaxObjectKeys(2),
+			v:     func() interface{} { return &testDepthObject{} },
+			limit: LimitObjectKeys,
+		},
+		{
+			name:  "object-nested-exceeded",
+			json:  `{"a":{"b":1,"c":2,"d":3}}`,
+			opt:   MaxObjectKeys(2),
+			v:     func() interface{} { return &testDepthObject{} },
+			limit: LimitObjectKeys,
+		},
+		{
+			name:  "object-interface-exceeded",
+			json:  `[{"a":1,"b":2,"c":3}]`,
+			opt:   MaxObjectKeys(2),
+			v:     func() interface{} { return new(interface{}) },
+			limit: LimitObjectKeys,
+		},
+		{
+			name:  "string-unknown-key-exceeded",
+			json:  `{"j":"abcd","a":{}}`,
+			opt:   MaxStringLength(3),
+			v:     func() interface{} { return &testDepthObject{} },
+			limit: LimitStringLength,
+		},
+		{
+			name: "string-unknown-key-escaped-at-limit",
+			json: `{"j":"a\u0041\n","a":{}}`,
+			opt:  MaxStringLength(3),
+			v:    func() interface{} { return &testDepthObject{} },
+		},
+		{
+			name:  "string-unknown-key-nested-exceeded",
+			json:  `{"j":[{"b":"abcd"}]}`,
+			opt:   MaxStringLength(3),
+			v:     func() interface{} { return &testDepthObject{} },
+			limit: LimitStringLength,
+		},
+		{
+			name:  "string-unknown-key-name-exceeded",
+			json:  `{"j":{"abcd":1}}`,
+			opt:   MaxStringLength(3),
+			v:     func() interface{} { return &testDepthObject{} },
+			limit: LimitStringLength,
+		},
+		{
+			name: "array-unknown-key-at-limit",
+			json: `{"junk":[1,[2,3,4],"]"],"a":{}}`,
+			opt:  MaxArrayLength(3),
+			v:    func() interface{} { return &testDepthObject{} },
+		},
+		{
+			name:  "array-unknown-key-exceeded",
+			json:  `{"junk":[1,2,3,4]}`,
+			opt:   MaxArrayLength(3),
+			v:     func() interface{} { return &testDepthObject{} },
+			limit: LimitArrayLength,
+		},
+		{
+			name:  "array-unknown-key-nested-exceeded",
+			json:  `{"junk":{"b":[[1,2,3,4]]}}`,
+			opt:   MaxArrayLength(3),
+			v:     func() interface{} { return &testDepthObject{} },
+			limit: LimitArrayLength,
+		},
+		{
+			name:  "array-skipped-element-exceeded",
+			json:  `[1,"a",{"b":[1,2,3,4]}]`,
+			opt:   MaxArrayLength(3),
+			v:     func() interface{} { return &testSliceInts{} },
+			limit: LimitArrayLength,
+		},
+		{
+			name: "object-unknown-key-at-limit",
+			json: `{"junk":{"b":{"c":1,"}":2}},"a":{}}`,
+			opt:  MaxObjectKeys(2),
+			v:    func() interface{} { return &testDepthObject{} },
+		},
+		{
+			name:  "object-unknown-key-exceeded",
+			json:  `{"junk":{"b":1,"c":2,"d":3}}`,
+			opt:   MaxObjectKeys(2),
+			v:     func() interface{} { return &testDepthObject{} },
+			limit: LimitObjectKeys,
+		},
+		{
+			name:  "object-rest-exceeded",
+			json:  `{"a":{"a":{},"b":1,"c":2}}`,
+			opt:   MaxObjectKeys(2),
+			v:     func() interface{} { return &testDepthObject{nKeys: 1} },
+			limit: LimitObjectKeys,
+		},
+	}
+	for _, testCase := range testCases {
+		for _, strict := range []bool{false, true} {
+			name := testCase.name
+			if strict {
+				name += "-strict"
+			}
+			t.Run(name, func(t *testing.T) {
+				opts := []DecoderOption{testCase.opt}
+				if strict {
+					opts = append(opts, Strict())
+				}
+				check := func(err error) {
+					if testCase.limit == "" {
+						require.Nil(t, err, "err should be nil")
+						return
+					}
+					require.IsType(t, &LimitError{}, err, "err should be of type *LimitError")
+					assert.Equal(t, testCase.limit, err.(*LimitError).Limit)
+				}
+				check(UnmarshalWithOptions([]byte(testCase.json), testCase.v(), opts...))
+
+				dec := BorrowDecoder(strings.NewReader(testCase.json))
+				defer dec.Release()
+				for _, opt := range opts {
+					opt(dec)
+				}
+				check(dec.Decode(testCase.v()))
+			})
+		}
+	}
+}
+
+func TestDecoderMaxBytesReader(t *testing.T) {
+	t.Run("endless-reader", func(t *testing.T) {
+		r := &endlessReader{}
+		dec := NewDecoder(r)
+		dec.SetMaxBytes(4096)
+		var v interface{}
+		err := dec.Decode(&v)
+		require.IsType(t, &LimitError{}, err, "err should be of type *LimitError")
+		assert.Equal(t, "Maximum input size of 4096 exceeded", err.Error())
+		assert.Equal(t, 4097, r.n, "the reader should not be read past the limit")
+		assert.True(t, len(dec.data) <= 8192, "the buffer should stay bounded")
+	})
+	t.Run("several-values", func(t *testing.T) {
+		dec := BorrowDecoder(strings.NewReader(`"abc" "def"`))
+		defer dec.Release()
+		dec.SetMaxBytes(8)
+		var s string
+		err := dec.Decode(&s)
+		require.IsType(t, &LimitError{}, err, "err should be of type *LimitError")
+		// once exceeded, the decoder keeps returning the error
+		err = dec.Decode(&s)
+		require.IsType(t, &LimitError{}, err, "err should be of type *LimitError")
+	})
+	t.Run("detailed", func(t *testing.T) {
+		dec := BorrowDecoder(strings.NewReader(`{"a":"abcdef"}`))
+		defer dec.Release()
+		dec.SetMaxBytes(8)
+		dec.UseDetailedErrors()
+		var v interface{}
+		err := dec.Decode(&v)
+		require.IsType(t, &DecodeError{}, err, "err should be of type *DecodeError")
+		assert.Equal(t, 8, err.(*DecodeError).Offset)
+		var limitErr *LimitError
+		require.True(t, errors.As(err, &limitErr), "err should wrap a *LimitError")
+		assert.Equal(t, LimitBytes, limitErr.Limit)
+		assert.Equal(t, 8, limitErr.Max)
+	})
+	t.Run("reset", func(t *testing.T) {
+		dec := BorrowDecoder(nil)
+		dec.SetMaxBytes(1)
+		dec.SetMaxStringLength(1)
+		dec.SetMaxArrayLength(1)
+		dec.SetMaxObjectKeys(1)
+		dec.Release()
+		dec = BorrowDecoder(strings.NewReader(`{"abc":[1,2],"d":1}`))
+		defer dec.Release()
+		var v interface{}
+		err := dec.Decode(&v)
+		assert.Nil(t, err, "err should be nil")
+	})
+}
+
+func TestStreamDecoderLimits(t *testing.T) {
+	t.Run("max-bytes", func(t *testing.T) {
+		// reading one byte at a time, the values before the limit are decoded
+		dec := Stream.BorrowDecoder(iotest.OneByteReader(strings.NewReader(`"abc" "def" "ghi"`)))
+		defer dec.Release()
+		dec.SetMaxBytes(12)
+		c := make(ChannelStreamStrings, 3)
+		err := dec.DecodeStream(c)
+		require.IsType(t, &LimitError{}, err, "err should be of type *LimitError")
+		assert.Equal(t, err, dec.Err())
+		assert.Len(t, c, 2)
+	})
+	t.Run("max-string-length", func(t *testing.T) {
+		dec := Stream.NewDecoder(strings.NewReader(`"abc" "defg"`))
+		dec.SetMaxStringLength(3)
+		c := make(ChannelStreamStrings, 2)
+		err := dec.DecodeStream(c)
+		require.IsType(t, &LimitError{}, err, "err should be of type *LimitError")
+		assert.Equal(t, LimitStringLength, err.(*LimitError).Limit)
+		assert.Len(t, c, 1)
+	})
+}
+
+func TestDecoderLimitsSkippedValues(t *testing.T) {
+	json := `{"junk":"` + strings.Repeat("x", 100000) + `","arr":[` + strings.Repeat("1,", 100000) + `1],"a":{}}`
+	for _, opt := range []DecoderOption{MaxStringLength(10), MaxArrayLength(10)} {
+		dec := BorrowDecoder(iotest.OneByteReader(strings.NewReader(json)))
+		opt(dec)
+		err := dec.Decode(&testDepthObject{})
+		dec.Release()
+		require.IsType(t, &LimitError{}, err, "err should be of type *LimitError")
+	}
+
+	v := &testDepthObject{}
+	err := UnmarshalWithOptions([]byte(json), v, MaxObjectKeys(10))
+	require.Nil(t, err, "err should be nil")
+	require.NotNil(t, v.a, "the known key should be decoded")
+}
+
+func TestDecoderLimitsEmbeddedJSON(t *testing.T) {
+	testCases := []struct {
+		name  string
+		json  string
+		opt   DecoderOption
+		limit Limit
+	}{
+		{name: "string", json: `"abcd"`, opt: MaxStringLength(3), limit: LimitStringLength},
+		{name: "string-nested", json: `[["abcd"]]`, opt: MaxStringLength(3), limit: LimitStringLength},
+		{name: "array", json: `[1,2,3,4]`, opt: MaxArrayLength(3), limit: LimitArrayLength},
+		{name: "object", json: `{"a":{"b":1,"c":2,"d":3}}`, opt: MaxObjectKeys(2), limit: LimitObjectKeys},
+		{name: "at-limit", json: `{"a":[1,2,"abc"],"b":{}}`, opt: MaxObjectKeys(2)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var v EmbeddedJSON
+			dec := BorrowDecoder(strings.NewReader(testCase.json))
+			defer dec.Release()
+			testCase.opt(dec)
+			dec.SetMaxStringLength(3)
+			err := dec.Decode(&v)
+			if testCase.limit == "" {
+				require.Nil(t, err, "err should be nil")
+				assert.Equal(t, testCase.json, string(v))
+				return
+			}
+			require.IsType(t, &LimitError{}, err, "err should be of type *LimitError")
+			assert.Equal(t, testCase.limit, err.(*LimitError).Limit)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package gojay
+
+// DecodeMap unmarshals the next JSON object to the given *map[string]V m,
+// each value is decoded by calling decodeValue.
+//
+// If m points to a nil map, a new map is allocated unless the JSON value is null.
+//
+// Example:
+//
+//	var m map[string]*User
+//	err := gojay.DecodeMap(dec, &m, func(dec *gojay.Decoder, v **User) error {
+//		*v = &User{}
+//		return dec.Object(*v)
+//	})
+func DecodeMap[V any](dec *Decoder, m *map[string]V, decodeValue func(dec *Decoder, v *V) error) error {
+	if *m == nil && dec.nextChar() != 'n' {
+		*m = make(map[string]V)
+	}
+	return dec.Object(DecodeObjectFunc(func(dec *Decoder, k string) error {
+		var v V
+		if err := decodeValue(dec, &v); err != nil {
+			return err
+		}
+		// key must be copied as it points to the buffer
+		(*m)[string([]byte(k))] = v
+		return nil
+	}))
+}
+
+// AddMapString unmarshals the next JSON object of strings to the given *map[string]string m
+func (dec *Decoder) AddMapString(m *map[string]string) error {
+	return dec.MapString(m)
+}
+
+// MapString unmarshals the next JSON object of strings to the given *map[string]string m
+func (dec *Decoder) MapString(m *map[string]string) error {
+	return DecodeMap(dec, m, (*Decoder).String)
+}
+
+// AddMapInt unmarshals the next JSON object of integers to the given *map[string]int m
+func (dec *Decoder) AddMapInt(m *map[string]int) error {
+	return dec.MapInt(m)
+}
+
+// MapInt unmarshals the next JSON object of integers to the given *map[string]int m
+func (dec *Decoder) MapInt(m *map[string]int) error {
+	return DecodeMap(dec, m, (*Decoder).Int)
+}
+
+// AddMapFloat64 unmarshals the next JSON object of floats to the given *map[string]float64 m
+func (dec *Decoder) AddMapFloat64(m *map[string]float64) error {
+	return dec.MapFloat64(m)
+}
+
+// MapFloat64 unmarshals the next JSON object of floats to the given *map[string]float64 m
+func (dec *Decoder) MapFloat64(m *map[string]float64) error {
+	return DecodeMap(dec, m, (*Decoder).Float64)
+}
+
+// AddMapBool unmarshals the next JSON object of booleans to the given *map[string]bool m
+func (dec *Decoder) AddMapBool(m *map[string]bool) error {
+	return dec.MapBool(m)
+}
+
+// MapBool unmarshals the next JSON object of booleans to the given *map[string]bool m
+func (dec *Decoder) MapBool(m *map[string]bool) error {
+	return DecodeMap(dec, m, (*Decoder).Bool)
+}
+
+// AddMapInterface unmarshals the next JSON object to the given *map[string]interface{} m
+func (dec *Decoder) AddMapInterface(m *map[string]interface{}) error {
+	return dec.MapInterface(m)
+}
+
+// MapInterface unmarshals the next JSON object to the given *map[string]interface{} m
+func (dec *Decoder) MapInterface(m *map[string]interface{}) error {
+	return DecodeMap(dec, m, (*Decoder).Interface)
+}
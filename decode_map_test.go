@@ -0,0 +1,179 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mapsTestObject struct {
+	mapString    map[string]string
+	mapInt       map[string]int
+	mapFloat64   map[string]float64
+	mapBool      map[string]bool
+	mapInterface map[string]interface{}
+	mapObject    map[string]*testPathItem
+}
+
+func (m *mapsTestObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "mapString":
+		return dec.AddMapString(&m.mapString)
+	case "mapInt":
+		return dec.AddMapInt(&m.mapInt)
+	case "mapFloat64":
+		return dec.AddMapFloat64(&m.mapFloat64)
+	case "mapBool":
+		return dec.AddMapBool(&m.mapBool)
+	case "mapInterface":
+		return dec.AddMapInterface(&m.mapInterface)
+	case "mapObject":
+		return DecodeMap(dec, &m.mapObject, func(dec *Decoder, v **testPathItem) error {
+			*v = &testPathItem{}
+			return dec.Object(*v)
+		})
+	}
+	return nil
+}
+
+func (m *mapsTestObject) NKeys() int {
+	return 6
+}
+
+func TestDecodeMaps(t *testing.T) {
+	testCases := []struct {
+		name           string
+		json           string
+		expectedResult mapsTestObject
+		err            bool
+	}{
+		{
+			name: "basic map string",
+			json: `{
+				"mapString": {"foo":"bar","baz":""}
+			}`,
+			expectedResult: mapsTestObject{
+				mapString: map[string]string{"foo": "bar", "baz": ""},
+			},
+		},
+		{
+			name: "basic map int",
+			json: `{
+				"mapInt": {"a":1,"b":-2}
+			}`,
+			expectedResult: mapsTestObject{
+				mapInt: map[string]int{"a": 1, "b": -2},
+			},
+		},
+		{
+			name: "basic map float64",
+			json: `{
+				"mapFloat64": {"a":1.3,"b":2}
+			}`,
+			expectedResult: mapsTestObject{
+				mapFloat64: map[string]float64{"a": 1.3, "b": 2},
+			},
+		},
+		{
+			name: "basic map bool",
+			json: `{
+				"mapBool": {"a":true,"b":false}
+			}`,
+			expectedResult: mapsTestObject{
+				mapBool: map[string]bool{"a": true, "b": false},
+			},
+		},
+		{
+			name: "basic map interface",
+			json: `{
+				"mapInterface": {"a":"b","c":[1,null],"d":{"e":true}}
+			}`,
+			expectedResult: mapsTestObject{
+				mapInterface: map[string]interface{}{
+					"a": "b",
+					"c": []interface{}{float64(1), nil},
+					"d": map[string]interface{}{"e": true},
+				},
+			},
+		},
+		{
+			name: "map of objects",
+			json: `{
+				"mapObject": {"a":{"name":"foo","price":1},"b":{"name":"bar"}}
+			}`,
+			expectedResult: mapsTestObject{
+				mapObject: map[string]*testPathItem{
+					"a": {name: "foo", price: 1},
+					"b": {name: "bar"},
+				},
+			},
+		},
+		{
+			name: "empty map",
+			json: `{
+				"mapString": {}
+			}`,
+			expectedResult: mapsTestObject{
+				mapString: map[string]string{},
+			},
+		},
+		{
+			name: "null map",
+			json: `{
+				"mapString": null
+			}`,
+			expectedResult: mapsTestObject{},
+		},
+		{
+			name: "err map int",
+			json: `{
+				"mapInt": {"a":1t}
+			}`,
+			err: true,
+		},
+		{
+			name: "err map string",
+			json: `{
+				"mapString": {"a":1}
+			}`,
+			err: true,
+		},
+		{
+			name: "err not an object",
+			json: `{
+				"mapBool": [true]
+			}`,
+			err: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				dec := BorrowDecoder(strings.NewReader(testCase.json))
+				defer dec.Release()
+				var o mapsTestObject
+				err := dec.Decode(&o)
+
+				if testCase.err {
+					require.NotNil(t, err, "err should not be nil")
+					return
+				}
+				require.Nil(t, err, "err should be nil")
+				require.Equal(t, testCase.expectedResult, o)
+			},
+		)
+	}
+}
+
+func TestDecodeMapKeysCopied(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(`{"first":1,"second":2}`))
+	var m map[string]int
+	err := dec.MapInt(&m)
+	require.Nil(t, err, "err should be nil")
+	// keys must not point to the buffer as it gets reused
+	copy(dec.data, strings.Repeat("x", len(dec.data)))
+	require.Equal(t, map[string]int{"first": 1, "second": 2}, m)
+}
@@ -116,3 +116,95 @@ func (dec *Decoder) getExponent() (int64, error) {
 	}
 	return dec.atoi64(start, end-1), nil
 }
+
+// getNumberLiteral returns the literal of the next JSON number, which may also be quoted,
+// and the position of the value. It returns a nil slice if the value is null.
+// If the value is neither a number nor a string holding a number, it records an InvalidUnmarshalError
+// for v, skips the value and returns a nil slice.
+// The returned slice points to the buffer.
+func (dec *Decoder) getNumberLiteral(v interface{}) ([]byte, int, error) {
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		switch dec.data[dec.cursor] {
+		case ' ', '\n', '\t', '\r', ',':
+			continue
+		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			start := dec.cursor
+			end := dec.cursor + 1
+			for ; end < dec.length || dec.read(); end++ {
+				if skipNumberEndCursorIncrement[dec.data[end]] == 0 {
+					break
+				}
+			}
+			if !isNumberLiteral(dec.data[start:end]) {
+				return nil, 0, dec.raiseInvalidJSONErr(start)
+			}
+			dec.cursor = end
+			return dec.data[start:end], start, nil
+		case '"':
+			start := dec.cursor
+			dec.cursor++
+			strStart, strEnd, err := dec.getString()
+			if err != nil {
+				return nil, 0, err
+			}
+			// we do minus one to remove the last quote
+			lit := dec.data[strStart : strEnd-1]
+			if !isNumberLiteral(lit) {
+				dec.cursor = start
+				dec.err = dec.makeInvalidUnmarshalErr(v)
+				dec.cursor = strEnd
+				return nil, 0, nil
+			}
+			dec.cursor = strEnd
+			return lit, start, nil
+		case 'n':
+			dec.cursor++
+			return nil, 0, dec.assertNull()
+		default:
+			return nil, 0, dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
+		}
+	}
+	return nil, 0, dec.raiseInvalidJSONErr(dec.cursor)
+}
+
+// isNumberLiteral reports whether b is a valid JSON number.
+func isNumberLiteral[T ~string | ~[]byte](b T) bool {
+	i := 0
+	if i < len(b) && b[i] == '-' {
+		i++
+	}
+	switch {
+	case i == len(b):
+		return false
+	case b[i] == '0':
+		i++
+	case isDigit(b[i]):
+		for i < len(b) && isDigit(b[i]) {
+			i++
+		}
+	default:
+		return false
+	}
+	if i < len(b) && b[i] == '.' {
+		i++
+		if i == len(b) || !isDigit(b[i]) {
+			return false
+		}
+		for i < len(b) && isDigit(b[i]) {
+			i++
+		}
+	}
+	if i < len(b) && (b[i] == 'e' || b[i] == 'E') {
+		i++
+		if i < len(b) && (b[i] == '+' || b[i] == '-') {
+			i++
+		}
+		if i == len(b) || !isDigit(b[i]) {
+			return false
+		}
+		for i < len(b) && isDigit(b[i]) {
+			i++
+		}
+	}
+	return i == len(b)
+}
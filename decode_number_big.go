@@ -0,0 +1,174 @@
+package gojay
+
+import (
+	"math/big"
+)
+
+// DecodeBigInt reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the big.Int pointed to by v.
+//
+// See the documentation for Unmarshal for details about the conversion of JSON into a Go value.
+func (dec *Decoder) DecodeBigInt(v *big.Int) error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
+	return dec.decodeBigInt(v)
+}
+func (dec *Decoder) decodeBigInt(v *big.Int) error {
+	lit, start, err := dec.getNumberLiteral(v)
+	if err != nil || lit == nil {
+		return err
+	}
+	dec.setBigInt(v, lit, start)
+	return nil
+}
+func (dec *Decoder) decodeBigIntNull(v **big.Int) error {
+	lit, start, err := dec.getNumberLiteral(v)
+	if err != nil || lit == nil {
+		return err
+	}
+	if *v == nil {
+		*v = new(big.Int)
+	}
+	dec.setBigInt(*v, lit, start)
+	return nil
+}
+
+// setBigInt sets v to the number lit, it records an InvalidUnmarshalError if lit is not an integer.
+func (dec *Decoder) setBigInt(v *big.Int, lit []byte, start int) {
+	i, ok := new(big.Int).SetString(string(lit), 10)
+	if !ok {
+		dec.setInvalidUnmarshalErr(v, start)
+		return
+	}
+	v.Set(i)
+}
+
+// DecodeBigFloat reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the big.Float pointed to by v.
+//
+// See the documentation for Unmarshal for details about the conversion of JSON into a Go value.
+func (dec *Decoder) DecodeBigFloat(v *big.Float) error {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
+	return dec.decodeBigFloat(v)
+}
+func (dec *Decoder) decodeBigFloat(v *big.Float) error {
+	lit, start, err := dec.getNumberLiteral(v)
+	if err != nil || lit == nil {
+		return err
+	}
+	dec.setBigFloat(v, lit, start)
+	return nil
+}
+func (dec *Decoder) decodeBigFloatNull(v **big.Float) error {
+	lit, start, err := dec.getNumberLiteral(v)
+	if err != nil || lit == nil {
+		return err
+	}
+	if *v == nil {
+		*v = new(big.Float)
+	}
+	dec.setBigFloat(*v, lit, start)
+	return nil
+}
+
+// setBigFloat sets v to the number lit.
+// If the precision of v is 0, it is set so that all the digits of lit are kept.
+func (dec *Decoder) setBigFloat(v *big.Float, lit []byte, start int) {
+	prec := v.Prec()
+	if prec == 0 {
+		// a decimal digit takes less than 4 bits
+		prec = uint(len(lit)) * 4
+		if prec < 64 {
+			prec = 64
+		}
+	}
+	f, _, err := big.ParseFloat(string(lit), 10, prec, v.Mode())
+	if err != nil {
+		dec.setInvalidUnmarshalErr(v, start)
+		return
+	}
+	v.SetPrec(prec).Set(f)
+}
+
+// Add Values functions
+
+// AddBigInt decodes the JSON value within an object or an array to a *big.Int.
+// The JSON value can be a number or a string holding a number, it must be an integer.
+func (dec *Decoder) AddBigInt(v *big.Int) error {
+	return dec.BigInt(v)
+}
+
+// AddBigIntNull decodes the JSON value within an object or an array to a **big.Int.
+// The JSON value can be a number or a string holding a number, it must be an integer.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) AddBigIntNull(v **big.Int) error {
+	return dec.BigIntNull(v)
+}
+
+// BigInt decodes the JSON value within an object or an array to a *big.Int.
+// The JSON value can be a number or a string holding a number, it must be an integer.
+func (dec *Decoder) BigInt(v *big.Int) error {
+	err := dec.decodeBigInt(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// BigIntNull decodes the JSON value within an object or an array to a **big.Int.
+// The JSON value can be a number or a string holding a number, it must be an integer.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) BigIntNull(v **big.Int) error {
+	err := dec.decodeBigIntNull(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// AddBigFloat decodes the JSON value within an object or an array to a *big.Float.
+// The JSON value can be a number or a string holding a number.
+// If the precision of v is 0, it is set so that all the digits of the number are kept.
+func (dec *Decoder) AddBigFloat(v *big.Float) error {
+	return dec.BigFloat(v)
+}
+
+// AddBigFloatNull decodes the JSON value within an object or an array to a **big.Float.
+// The JSON value can be a number or a string holding a number.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) AddBigFloatNull(v **big.Float) error {
+	return dec.BigFloatNull(v)
+}
+
+// BigFloat decodes the JSON value within an object or an array to a *big.Float.
+// The JSON value can be a number or a string holding a number.
+// If the precision of v is 0, it is set so that all the digits of the number are kept.
+func (dec *Decoder) BigFloat(v *big.Float) error {
+	err := dec.decodeBigFloat(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// BigFloatNull decodes the JSON value within an object or an array to a **big.Float.
+// The JSON value can be a number or a string holding a number.
+// If a `null` is encountered, gojay does not change the value of the pointer.
+func (dec *Decoder) BigFloatNull(v **big.Float) error {
+	err := dec.decodeBigFloatNull(v)
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
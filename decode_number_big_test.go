@@ -0,0 +1,272 @@
+package gojay
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoderBigInt(t *testing.T) {
+	testCases := []struct {
+		name           string
+		json           string
+		expectedResult string
+		err            bool
+		errType        interface{}
+	}{
+		{
+			name:           "basic-positive",
+			json:           "100",
+			expectedResult: "100",
+		},
+		{
+			name:           "basic-negative",
+			json:           "-100",
+			expectedResult: "-100",
+		},
+		{
+			name:           "overflow-uint64",
+			json:           "340282366920938463463374607431768211455",
+			expectedResult: "340282366920938463463374607431768211455",
+		},
+		{
+			name:           "overflow-negative",
+			json:           " -340282366920938463463374607431768211456 ",
+			expectedResult: "-340282366920938463463374607431768211456",
+		},
+		{
+			name:           "quoted",
+			json:           `"123456789012345678901234567890"`,
+			expectedResult: "123456789012345678901234567890",
+		},
+		{
+			name:           "null",
+			json:           "null",
+			expectedResult: "0",
+		},
+		{
+			name:    "fraction",
+			json:    "1.5",
+			err:     true,
+			errType: InvalidUnmarshalError(""),
+		},
+		{
+			name:    "exponent",
+			json:    "1e10",
+			err:     true,
+			errType: InvalidUnmarshalError(""),
+		},
+		{
+			name:    "quoted-not-a-number",
+			json:    `"abc"`,
+			err:     true,
+			errType: InvalidUnmarshalError(""),
+		},
+		{
+			name:    "quoted-invalid-number",
+			json:    `"01"`,
+			err:     true,
+			errType: InvalidUnmarshalError(""),
+		},
+		{
+			name:    "bool",
+			json:    "true",
+			err:     true,
+			errType: InvalidUnmarshalError(""),
+		},
+		{
+			name:    "invalid-json",
+			json:    "1-2",
+			err:     true,
+			errType: InvalidJSONError(""),
+		},
+		{
+			name:    "invalid-json-leading-zero",
+			json:    "012",
+			err:     true,
+			errType: InvalidJSONError(""),
+		},
+		{
+			name:    "invalid-null",
+			json:    "nul",
+			err:     true,
+			errType: InvalidJSONError(""),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := new(big.Int)
+			err := Unmarshal([]byte(testCase.json), v)
+			if testCase.err {
+				require.NotNil(t, err, "err should not be nil")
+				assert.IsType(t, testCase.errType, err, "err should be of expected type")
+				return
+			}
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expectedResult, v.String())
+		})
+	}
+	t.Run("decoder", func(t *testing.T) {
+		v := new(big.Int)
+		dec := BorrowDecoder(strings.NewReader("18446744073709551616"))
+		defer dec.Release()
+		err := dec.DecodeBigInt(v)
+		require.Nil(t, err, "err should be nil")
+		assert.Equal(t, "18446744073709551616", v.String())
+	})
+	t.Run("decoder-pooled", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader("1"))
+		dec.Release()
+		defer func() {
+			err := recover()
+			assert.NotNil(t, err, "err shouldnt be nil")
+			assert.IsType(t, InvalidUsagePooledDecoderError(""), err, "err should be of type InvalidUsagePooledDecoderError")
+		}()
+		_ = dec.DecodeBigInt(new(big.Int))
+		assert.True(t, false, "should not be called as decoder should have panicked")
+	})
+}
+
+func TestDecoderBigFloat(t *testing.T) {
+	testCases := []struct {
+		name           string
+		json           string
+		prec           uint
+		expectedResult string
+		err            bool
+		errType        interface{}
+	}{
+		{
+			name:           "basic",
+			json:           "1.5",
+			expectedResult: "1.5",
+		},
+		{
+			name:           "integer",
+			json:           "-100",
+			expectedResult: "-100",
+		},
+		{
+			name:           "exponent",
+			json:           "1.25e-3",
+			expectedResult: "0.00125",
+		},
+		{
+			name:           "full-precision",
+			json:           "3.14159265358979323846264338327950288419716939937510582097494459",
+			expectedResult: "3.14159265358979323846264338327950288419716939937510582097494459",
+		},
+		{
+			name:           "large",
+			json:           "123456789012345678901234567890.123456789",
+			expectedResult: "123456789012345678901234567890.123456789",
+		},
+		{
+			name:           "quoted",
+			json:           `"0.1"`,
+			expectedResult: "0.1",
+		},
+		{
+			name:           "given-precision",
+			json:           "3.14159265358979323846264338327950288419716939937510582097494459",
+			prec:           24,
+			expectedResult: "3.1415927",
+		},
+		{
+			name:           "null",
+			json:           "null",
+			expectedResult: "0",
+		},
+		{
+			name:    "string",
+			json:    `"abc"`,
+			err:     true,
+			errType: InvalidUnmarshalError(""),
+		},
+		{
+			name:    "object",
+			json:    `{"a":1}`,
+			err:     true,
+			errType: InvalidUnmarshalError(""),
+		},
+		{
+			name:    "invalid-json",
+			json:    "1.e2",
+			err:     true,
+			errType: InvalidJSONError(""),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := new(big.Float).SetPrec(testCase.prec)
+			err := Unmarshal([]byte(testCase.json), v)
+			if testCase.err {
+				require.NotNil(t, err, "err should not be nil")
+				assert.IsType(t, testCase.errType, err, "err should be of expected type")
+				return
+			}
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expectedResult, v.Text('f', -1))
+			if testCase.prec != 0 {
+				assert.Equal(t, testCase.prec, v.Prec(), "precision should be kept")
+			}
+		})
+	}
+}
+
+type testBigNumbers struct {
+	i    *big.Int
+	f    *big.Float
+	iPtr *big.Int
+	fPtr *big.Float
+	n    int
+}
+
+func (t *testBigNumbers) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "i":
+		t.i = new(big.Int)
+		return dec.BigInt(t.i)
+	case "f":
+		t.f = new(big.Float)
+		return dec.AddBigFloat(t.f)
+	case "iPtr":
+		return dec.AddBigIntNull(&t.iPtr)
+	case "fPtr":
+		return dec.BigFloatNull(&t.fPtr)
+	case "n":
+		return dec.Int(&t.n)
+	}
+	return nil
+}
+
+func (t *testBigNumbers) NKeys() int {
+	return 0
+}
+
+func TestDecoderBigNumbersObject(t *testing.T) {
+	v := &testBigNumbers{}
+	err := UnmarshalJSONObject([]byte(`{"i":"99999999999999999999","f":1.000000000000000000001,"iPtr":-5,"fPtr":null,"n":1}`), v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "99999999999999999999", v.i.String())
+	assert.Equal(t, "1.000000000000000000001", v.f.Text('f', -1))
+	require.NotNil(t, v.iPtr, "v.iPtr should not be nil")
+	assert.Equal(t, "-5", v.iPtr.String())
+	assert.Nil(t, v.fPtr, "v.fPtr should be nil")
+	assert.Equal(t, 1, v.n)
+
+	v = &testBigNumbers{}
+	err = UnmarshalJSONObjectWithOptions([]byte(`{"i":1.5,"iPtr":"x","n":1}`), v, AllErrors())
+	require.IsType(t, DecodeErrors{}, err, "err should be of type DecodeErrors")
+	errs := err.(DecodeErrors)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "$.i", errs[0].Path)
+	assert.Equal(t, "1.5", errs[0].Token)
+	assert.Equal(t, "$.iPtr", errs[1].Path)
+	assert.Equal(t, `"x"`, errs[1].Token)
+	assert.Nil(t, v.iPtr, "v.iPtr should be nil")
+	assert.Equal(t, 1, v.n)
+}
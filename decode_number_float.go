@@ -7,6 +7,9 @@ func (dec *Decoder) DecodeFloat64(v *float64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeFloat64(v)
 }
 func (dec *Decoder) decodeFloat64(v *float64) error {
@@ -36,9 +39,15 @@ func (dec *Decoder) decodeFloat64(v *float64) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedFloat(v, 64)
+			if err != nil || !ok {
+				return err
+			}
+			*v = val
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -80,9 +89,18 @@ func (dec *Decoder) decodeFloat64Null(v **float64) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedFloat(v, 64)
+			if err != nil || !ok {
+				return err
+			}
+			if *v == nil {
+				*v = new(float64)
+			}
+			**v = val
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -106,106 +124,20 @@ func (dec *Decoder) getFloatNegative() (float64, error) {
 }
 
 func (dec *Decoder) getFloat() (float64, error) {
-	var end = dec.cursor
-	var start = dec.cursor
-	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
-		switch dec.data[j] {
-		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-			end = j
-			continue
-		case '.':
-			// we get part before decimal as integer
-			beforeDecimal := dec.atoi64(start, end)
-			// then we get part after decimal as integer
-			start = j + 1
-			// get number after the decimal point
-			for i := j + 1; i < dec.length || dec.read(); i++ {
-				c := dec.data[i]
-				if isDigit(c) {
-					end = i
-					// multiply the before decimal point portion by 10 using bitwise
-					// make sure it doesn't overflow
-					if end-start < 18 {
-						beforeDecimal = (beforeDecimal << 3) + (beforeDecimal << 1)
-					}
-					continue
-				} else if (c == 'e' || c == 'E') && j < i-1 {
-					// we have an exponent, convert first the value we got before the exponent
-					var afterDecimal int64
-					expI := end - start + 2
-					// if exp is too long, it means number is too long, just truncate the number
-					if expI >= len(pow10uint64) || expI < 0 {
-						expI = len(pow10uint64) - 2
-						afterDecimal = dec.atoi64(start, start+expI-2)
-					} else {
-						// then we add both integers
-						// then we divide the number by the power found
-						afterDecimal = dec.atoi64(start, end)
-					}
-					dec.cursor = i + 1
-					pow := pow10uint64[expI]
-					floatVal := float64(beforeDecimal+afterDecimal) / float64(pow)
-					exp, err := dec.getExponent()
-					if err != nil {
-						return 0, err
-					}
-					pExp := (exp + (exp >> 31)) ^ (exp >> 31) + 1 // absolute exponent
-					if pExp >= int64(len(pow10uint64)) || pExp < 0 {
-						return 0, dec.raiseInvalidJSONErr(dec.cursor)
-					}
-					// if exponent is negative
-					if exp < 0 {
-						return float64(floatVal) * (1 / float64(pow10uint64[pExp])), nil
-					}
-					return float64(floatVal) * float64(pow10uint64[pExp]), nil
-				}
-				dec.cursor = i
-				break
-			}
-			if end >= dec.length || end < start {
-				return 0, dec.raiseInvalidJSONErr(dec.cursor)
-			}
-			var afterDecimal int64
-			expI := end - start + 2
-			// if exp is too long, it means number is too long, just truncate the number
-			if expI >= len(pow10uint64) || expI < 0 {
-				expI = 19
-				afterDecimal = dec.atoi64(start, start+expI-2)
-			} else {
-				afterDecimal = dec.atoi64(start, end)
-			}
-
-			pow := pow10uint64[expI]
-			// then we add both integers
-			// then we divide the number by the power found
-			return float64(beforeDecimal+afterDecimal) / float64(pow), nil
-		case 'e', 'E':
-			dec.cursor = j + 1
-			// we get part before decimal as integer
-			beforeDecimal := uint64(dec.atoi64(start, end))
-			// get exponent
-			exp, err := dec.getExponent()
-			if err != nil {
-				return 0, err
-			}
-			pExp := (exp + (exp >> 31)) ^ (exp >> 31) + 1 // abs
-			if pExp >= int64(len(pow10uint64)) || pExp < 0 {
-				return 0, dec.raiseInvalidJSONErr(dec.cursor)
-			}
-			// if exponent is negative
-			if exp < 0 {
-				return float64(beforeDecimal) * (1 / float64(pow10uint64[pExp])), nil
-			}
-			return float64(beforeDecimal) * float64(pow10uint64[pExp]), nil
-		case ' ', '\n', '\t', '\r', ',', '}', ']': // does not have decimal
-			dec.cursor = j
-			return float64(dec.atoi64(start, end)), nil
+	start := dec.cursor
+	mantissa, exp10, trunc, err := dec.readFloat()
+	if err != nil {
+		return 0, err
+	}
+	if !trunc {
+		if f, ok := atof64exact(mantissa, exp10); ok {
+			return f, nil
+		}
+		if f, ok := eiselLemire64(mantissa, exp10); ok {
+			return f, nil
 		}
-		// invalid json we expect numbers, dot (single one), comma, or spaces
-		return 0, dec.raiseInvalidJSONErr(dec.cursor)
 	}
-	return float64(dec.atoi64(start, end)), nil
+	return dec.parseFloatLiteral(start, 64, float64(0))
 }
 
 // DecodeFloat32 reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the float32 pointed to by v.
@@ -215,6 +147,9 @@ func (dec *Decoder) DecodeFloat32(v *float32) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeFloat32(v)
 }
 func (dec *Decoder) decodeFloat32(v *float32) error {
@@ -244,9 +179,15 @@ func (dec *Decoder) decodeFloat32(v *float32) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedFloat(v, 32)
+			if err != nil || !ok {
+				return err
+			}
+			*v = float32(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -288,9 +229,18 @@ func (dec *Decoder) decodeFloat32Null(v **float32) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedFloat(v, 32)
+			if err != nil || !ok {
+				return err
+			}
+			if *v == nil {
+				*v = new(float32)
+			}
+			**v = float32(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -314,108 +264,21 @@ func (dec *Decoder) getFloat32Negative() (float32, error) {
 }
 
 func (dec *Decoder) getFloat32() (float32, error) {
-	var end = dec.cursor
-	var start = dec.cursor
-	// look for following numbers
-	for j := dec.cursor + 1; j < dec.length || dec.read(); j++ {
-		switch dec.data[j] {
-		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-			end = j
-			continue
-		case '.':
-			// we get part before decimal as integer
-			beforeDecimal := dec.atoi64(start, end)
-			// then we get part after decimal as integer
-			start = j + 1
-			// get number after the decimal point
-			// multiple the before decimal point portion by 10 using bitwise
-			for i := j + 1; i < dec.length || dec.read(); i++ {
-				c := dec.data[i]
-				if isDigit(c) {
-					end = i
-					// multiply the before decimal point portion by 10 using bitwise
-					// make sure it desn't overflow
-					if end-start < 9 {
-						beforeDecimal = (beforeDecimal << 3) + (beforeDecimal << 1)
-					}
-					continue
-				} else if (c == 'e' || c == 'E') && j < i-1 {
-					// we get the number before decimal
-					var afterDecimal int64
-					expI := end - start + 2
-					// if exp is too long, it means number is too long, just truncate the number
-					if expI >= 12 || expI < 0 {
-						expI = 10
-						afterDecimal = dec.atoi64(start, start+expI-2)
-					} else {
-						afterDecimal = dec.atoi64(start, end)
-					}
-					dec.cursor = i + 1
-					pow := pow10uint64[expI]
-					// then we add both integers
-					// then we divide the number by the power found
-					floatVal := float32(beforeDecimal+afterDecimal) / float32(pow)
-					exp, err := dec.getExponent()
-					if err != nil {
-						return 0, err
-					}
-					pExp := (exp + (exp >> 31)) ^ (exp >> 31) + 1 // abs
-					if pExp >= int64(len(pow10uint64)) || pExp < 0 {
-						return 0, dec.raiseInvalidJSONErr(dec.cursor)
-					}
-					// if exponent is negative
-					if exp < 0 {
-						return float32(floatVal) * (1 / float32(pow10uint64[pExp])), nil
-					}
-					return float32(floatVal) * float32(pow10uint64[pExp]), nil
-				}
-				dec.cursor = i
-				break
-			}
-			if end >= dec.length || end < start {
-				return 0, dec.raiseInvalidJSONErr(dec.cursor)
-			}
-			// then we add both integers
-			// then we divide the number by the power found
-			var afterDecimal int64
-			expI := end - start + 2
-			// if exp is too long, it means number is too long, just truncate the number
-			if expI >= 12 || expI < 0 {
-				expI = 10
-				afterDecimal = dec.atoi64(start, start+expI-2)
-			} else {
-				// then we add both integers
-				// then we divide the number by the power found
-				afterDecimal = dec.atoi64(start, end)
-			}
-			pow := pow10uint64[expI]
-			return float32(beforeDecimal+afterDecimal) / float32(pow), nil
-		case 'e', 'E':
-			dec.cursor = j + 1
-			// we get part before decimal as integer
-			beforeDecimal := dec.atoi64(start, end)
-			// get exponent
-			exp, err := dec.getExponent()
-			if err != nil {
-				return 0, err
-			}
-			pExp := (exp + (exp >> 31)) ^ (exp >> 31) + 1
-			if pExp >= int64(len(pow10uint64)) || pExp < 0 {
-				return 0, dec.raiseInvalidJSONErr(dec.cursor)
-			}
-			// if exponent is negative
-			if exp < 0 {
-				return float32(beforeDecimal) * (1 / float32(pow10uint64[pExp])), nil
-			}
-			return float32(beforeDecimal) * float32(pow10uint64[pExp]), nil
-		case ' ', '\n', '\t', '\r', ',', '}', ']': // does not have decimal
-			dec.cursor = j
-			return float32(dec.atoi64(start, end)), nil
+	start := dec.cursor
+	mantissa, exp10, trunc, err := dec.readFloat()
+	if err != nil {
+		return 0, err
+	}
+	if !trunc {
+		if f, ok := atof32exact(mantissa, exp10); ok {
+			return f, nil
+		}
+		if f, ok := eiselLemire32(mantissa, exp10); ok {
+			return f, nil
 		}
-		// invalid json we expect numbers, dot (single one), comma, or spaces
-		return 0, dec.raiseInvalidJSONErr(dec.cursor)
 	}
-	return float32(dec.atoi64(start, end)), nil
+	f, err := dec.parseFloatLiteral(start, 32, float32(0))
+	return float32(f), err
 }
 
 // Add Values functions
@@ -0,0 +1,318 @@
+package gojay
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"sync"
+	"unsafe"
+)
+
+// Floats are parsed the way strconv.ParseFloat does, so that they are bit-identical to encoding/json:
+// the digits are read as a decimal mantissa and exponent, then converted by an exact fast path when
+// both fit in a float, else by the Eisel-Lemire algorithm, else by strconv.ParseFloat itself.
+
+// maxMantissaDigits is the number of significant digits kept in a decimal mantissa.
+const maxMantissaDigits = 19
+
+var float64pow10 = [...]float64{
+	1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+	1e20, 1e21, 1e22,
+}
+
+var float32pow10 = [...]float32{1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10}
+
+// readFloat reads the number at the cursor, its sign being already consumed,
+// and returns it as a decimal mantissa and exponent.
+// Only the first 19 significant digits are kept in the mantissa, trunc reports whether a non zero digit was dropped.
+func (dec *Decoder) readFloat() (mantissa uint64, exp10 int, trunc bool, err error) {
+	var nd int
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		c := dec.data[dec.cursor]
+		if isDigit(c) {
+			if nd < maxMantissaDigits {
+				mantissa = mantissa*10 + uint64(c-'0')
+				if mantissa > 0 {
+					nd++
+				}
+			} else {
+				exp10++
+				trunc = trunc || c != '0'
+			}
+			continue
+		}
+		switch c {
+		case '.':
+			dec.cursor++
+			return dec.readFloatFraction(mantissa, exp10, nd, trunc)
+		case 'e', 'E':
+			dec.cursor++
+			return dec.readFloatExponent(mantissa, exp10, trunc)
+		case ' ', '\n', '\t', '\r', ',', '}', ']':
+			return mantissa, exp10, trunc, nil
+		}
+		// invalid json we expect numbers, dot (single one), comma, or spaces
+		return 0, 0, false, dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	return mantissa, exp10, trunc, nil
+}
+
+// readFloatFraction reads the digits after the decimal point, there must be at least one.
+func (dec *Decoder) readFloatFraction(mantissa uint64, exp10 int, nd int, trunc bool) (uint64, int, bool, error) {
+	start := dec.cursor
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		c := dec.data[dec.cursor]
+		if isDigit(c) {
+			if nd < maxMantissaDigits {
+				mantissa = mantissa*10 + uint64(c-'0')
+				exp10--
+				if mantissa > 0 {
+					nd++
+				}
+			} else {
+				trunc = trunc || c != '0'
+			}
+			continue
+		}
+		if dec.cursor == start {
+			return 0, 0, false, dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		if c == 'e' || c == 'E' {
+			dec.cursor++
+			return dec.readFloatExponent(mantissa, exp10, trunc)
+		}
+		return mantissa, exp10, trunc, nil
+	}
+	if dec.cursor == start {
+		return 0, 0, false, dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	return mantissa, exp10, trunc, nil
+}
+
+// readFloatExponent reads the exponent after 'e' and adds it to exp10.
+// Exponents beyond 10000 give the same result, as strconv.ParseFloat, so they are not read further.
+func (dec *Decoder) readFloatExponent(mantissa uint64, exp10 int, trunc bool) (uint64, int, bool, error) {
+	var neg bool
+	if dec.cursor < dec.length || dec.read() {
+		switch dec.data[dec.cursor] {
+		case '-':
+			neg = true
+			dec.cursor++
+		case '+':
+			dec.cursor++
+		}
+	}
+	start := dec.cursor
+	var e int
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		c := dec.data[dec.cursor]
+		if !isDigit(c) {
+			break
+		}
+		if e < 10000 {
+			e = e*10 + int(c-'0')
+		}
+	}
+	if dec.cursor == start {
+		return 0, 0, false, dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	if neg {
+		e = -e
+	}
+	return mantissa, exp10 + e, trunc, nil
+}
+
+// parseFloatLiteral parses the number from start to the cursor with strconv.ParseFloat.
+// If it overflows, an InvalidUnmarshalError for v is returned.
+func (dec *Decoder) parseFloatLiteral(start int, bitSize int, v interface{}) (float64, error) {
+	lit := dec.data[start:dec.cursor]
+	f, err := strconv.ParseFloat(*(*string)(unsafe.Pointer(&lit)), bitSize)
+	if err != nil {
+		return 0, dec.makeInvalidUnmarshalErr(v)
+	}
+	return f, nil
+}
+
+// atof64exact returns mantissa*10^exp10 if both are exact as float64s, the result then being correctly rounded.
+func atof64exact(mantissa uint64, exp10 int) (float64, bool) {
+	if mantissa > 1<<53 {
+		return 0, false
+	}
+	f := float64(mantissa)
+	switch {
+	case exp10 == 0:
+		return f, true
+	case exp10 > 0 && exp10 < len(float64pow10):
+		return f * float64pow10[exp10], true
+	case exp10 < 0 && -exp10 < len(float64pow10):
+		return f / float64pow10[-exp10], true
+	}
+	return 0, false
+}
+
+// atof32exact returns mantissa*10^exp10 if both are exact as float32s, the result then being correctly rounded.
+func atof32exact(mantissa uint64, exp10 int) (float32, bool) {
+	if mantissa > 1<<24 {
+		return 0, false
+	}
+	f := float32(mantissa)
+	switch {
+	case exp10 == 0:
+		return f, true
+	case exp10 > 0 && exp10 < len(float32pow10):
+		return f * float32pow10[exp10], true
+	case exp10 < 0 && -exp10 < len(float32pow10):
+		return f / float32pow10[-exp10], true
+	}
+	return 0, false
+}
+
+const (
+	detailedPowersOfTenMinExp10 = -348
+	detailedPowersOfTenMaxExp10 = +347
+)
+
+// detailedPowersOfTen holds the 128-bit mantissas, rounded down, of the powers of 10
+// from 1e-348 to 1e347, as {low, high} pairs. It is computed on first use.
+var detailedPowersOfTen [detailedPowersOfTenMaxExp10 - detailedPowersOfTenMinExp10 + 1][2]uint64
+var detailedPowersOfTenOnce sync.Once
+
+func initDetailedPowersOfTen() {
+	ten := big.NewInt(10)
+	lowMask := new(big.Int).SetUint64(math.MaxUint64)
+	m := new(big.Int)
+	for i := range detailedPowersOfTen {
+		exp10 := i + detailedPowersOfTenMinExp10
+		if exp10 >= 0 {
+			m.Exp(ten, big.NewInt(int64(exp10)), nil)
+			if n := m.BitLen(); n > 128 {
+				m.Rsh(m, uint(n-128))
+			} else {
+				m.Lsh(m, uint(128-n))
+			}
+		} else {
+			// 2^(127+n) / 10^-exp10 is within [2^127, 2^128) when 10^-exp10 has n bits
+			pow := new(big.Int).Exp(ten, big.NewInt(int64(-exp10)), nil)
+			m.Lsh(big.NewInt(1), uint(127+pow.BitLen()))
+			m.Quo(m, pow)
+		}
+		detailedPowersOfTen[i][0] = new(big.Int).And(m, lowMask).Uint64()
+		detailedPowersOfTen[i][1] = new(big.Int).Rsh(m, 64).Uint64()
+	}
+}
+
+// eiselLemire64 returns the float64 nearest to mantissa*10^exp10 using the Eisel-Lemire algorithm.
+// It returns false when it cannot decide on the rounding, or for subnormals and overflows,
+// the caller must then fall back to an exact algorithm.
+func eiselLemire64(mantissa uint64, exp10 int) (float64, bool) {
+	if mantissa == 0 {
+		return 0, true
+	}
+	if exp10 < detailedPowersOfTenMinExp10 || exp10 > detailedPowersOfTenMaxExp10 {
+		return 0, false
+	}
+	detailedPowersOfTenOnce.Do(initDetailedPowersOfTen)
+	pow := &detailedPowersOfTen[exp10-detailedPowersOfTenMinExp10]
+
+	// normalize the mantissa
+	clz := bits.LeadingZeros64(mantissa)
+	mantissa <<= uint(clz)
+	const float64ExponentBias = 1023
+	// 217706*exp10>>16 is floor(exp10*log2(10))
+	retExp2 := uint64(217706*exp10>>16+64+float64ExponentBias) - uint64(clz)
+
+	// multiply by the power of 10, using its low 64 bits only if the high ones are not enough
+	xHi, xLo := bits.Mul64(mantissa, pow[1])
+	if xHi&0x1FF == 0x1FF && xLo+mantissa < mantissa {
+		yHi, yLo := bits.Mul64(mantissa, pow[0])
+		mergedHi, mergedLo := xHi, xLo+yHi
+		if mergedLo < xLo {
+			mergedHi++
+		}
+		if mergedHi&0x1FF == 0x1FF && mergedLo+1 == 0 && yLo+mantissa < mantissa {
+			return 0, false
+		}
+		xHi, xLo = mergedHi, mergedLo
+	}
+
+	// shift to 54 bits
+	msb := xHi >> 63
+	retMantissa := xHi >> (msb + 9)
+	retExp2 -= 1 ^ msb
+
+	// the result may be halfway between two floats
+	if xLo == 0 && xHi&0x1FF == 0 && retMantissa&3 == 1 {
+		return 0, false
+	}
+
+	// round from 54 to 53 bits
+	retMantissa += retMantissa & 1
+	retMantissa >>= 1
+	if retMantissa>>53 > 0 {
+		retMantissa >>= 1
+		retExp2++
+	}
+	// retExp2 is 0 or has underflowed for subnormals, and is 0x7FF or above for overflows
+	if retExp2-1 >= 0x7FF-1 {
+		return 0, false
+	}
+	return math.Float64frombits(retExp2<<52 | retMantissa&(1<<52-1)), true
+}
+
+// eiselLemire32 is like eiselLemire64 for float32.
+func eiselLemire32(mantissa uint64, exp10 int) (float32, bool) {
+	if mantissa == 0 {
+		return 0, true
+	}
+	if exp10 < detailedPowersOfTenMinExp10 || exp10 > detailedPowersOfTenMaxExp10 {
+		return 0, false
+	}
+	detailedPowersOfTenOnce.Do(initDetailedPowersOfTen)
+	pow := &detailedPowersOfTen[exp10-detailedPowersOfTenMinExp10]
+
+	// normalize the mantissa
+	clz := bits.LeadingZeros64(mantissa)
+	mantissa <<= uint(clz)
+	const float32ExponentBias = 127
+	// 217706*exp10>>16 is floor(exp10*log2(10))
+	retExp2 := uint64(217706*exp10>>16+64+float32ExponentBias) - uint64(clz)
+
+	// multiply by the power of 10, using its low 64 bits only if the high ones are not enough
+	xHi, xLo := bits.Mul64(mantissa, pow[1])
+	if xHi&0x3FFFFFFFFF == 0x3FFFFFFFFF && xLo+mantissa < mantissa {
+		yHi, yLo := bits.Mul64(mantissa, pow[0])
+		mergedHi, mergedLo := xHi, xLo+yHi
+		if mergedLo < xLo {
+			mergedHi++
+		}
+		if mergedHi&0x3FFFFFFFFF == 0x3FFFFFFFFF && mergedLo+1 == 0 && yLo+mantissa < mantissa {
+			return 0, false
+		}
+		xHi, xLo = mergedHi, mergedLo
+	}
+
+	// shift to 25 bits
+	msb := xHi >> 63
+	retMantissa := xHi >> (msb + 38)
+	retExp2 -= 1 ^ msb
+
+	// the result may be halfway between two floats
+	if xLo == 0 && xHi&0x3FFFFFFFFF == 0 && retMantissa&3 == 1 {
+		return 0, false
+	}
+
+	// round from 25 to 24 bits
+	retMantissa += retMantissa & 1
+	retMantissa >>= 1
+	if retMantissa>>24 > 0 {
+		retMantissa >>= 1
+		retExp2++
+	}
+	// retExp2 is 0 or has underflowed for subnormals, and is 0xFF or above for overflows
+	if retExp2-1 >= 0xFF-1 {
+		return 0, false
+	}
+	return math.Float32frombits(uint32(retExp2<<23 | retMantissa&(1<<23-1))), true
+}
@@ -0,0 +1,121 @@
+package gojay
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// hardFloats are inputs which are not correctly rounded by naive parsing.
+var hardFloats = []string{
+	"0", "-0", "0.0", "1", "0.1", "0.3", "1e23", "8.41e21", "5e-324", "4.9e-324", "2e-324", "3e-324",
+	"2.2250738585072011e-308", "2.2250738585072012e-308", "2.225073858507201136057409796709131975934819546351645648e-308",
+	"1.7976931348623157e308", "1.7976931348623158e308", "4.940656458412465441765687928682213723651e-324",
+	"9007199254740993", "9007199254740992.5", "9007199254740993.0000000000000000001", "18446744073709551617",
+	"123456789012345678901234567890", "0.000000000000000000000000000000000000000000001e45",
+	"1.00000000000000011102230246251565404236316680908203125", "1.00000000000000011102230246251565404236316680908203124",
+	"1.00000000000000011102230246251565404236316680908203126", "7.038531e-26", "1.1754943508222875e-38",
+	"1.401298464324817e-45", "3.4028234663852886e38", "3.4028235677973366e38", "16777217", "16777216.5",
+	"1.000000059604644775390625", "1.000000059604644775390624", "0.0000000000000000000000000000000000000000000000000000001",
+	"100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e-92",
+	"2.4595", "1.00232492420002423545849009", "1.5e3", "12.5E+1", "12.5E-1", "1e-350", "0e999",
+}
+
+func TestDecoderFloatCorrectlyRounded(t *testing.T) {
+	inputs := append([]string{}, hardFloats...)
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 20000; i++ {
+		f := math.Float64frombits(r.Uint64())
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			continue
+		}
+		inputs = append(inputs, strconv.FormatFloat(f, 'e', -1, 64), strconv.FormatFloat(f, 'e', r.Intn(25), 64))
+		f32 := math.Float32frombits(r.Uint32())
+		if math.IsNaN(float64(f32)) || math.IsInf(float64(f32), 0) {
+			continue
+		}
+		inputs = append(inputs, strconv.FormatFloat(float64(f32), 'e', -1, 32), strconv.FormatFloat(float64(f32), 'g', r.Intn(12), 32))
+		inputs = append(inputs, strconv.FormatUint(r.Uint64()>>uint(r.Intn(64)), 10)+"."+strconv.FormatUint(r.Uint64(), 10)+"e"+strconv.Itoa(r.Intn(700)-350))
+	}
+	for _, input := range inputs {
+		expected64, err64 := strconv.ParseFloat(input, 64)
+		var v64 float64
+		err := Unmarshal([]byte(input), &v64)
+		if err64 != nil {
+			assert.IsType(t, InvalidUnmarshalError(""), err, input)
+		} else if assert.Nil(t, err, input) {
+			assert.Equal(t, math.Float64bits(expected64), math.Float64bits(v64), input)
+		}
+
+		expected32, err32 := strconv.ParseFloat(input, 32)
+		var v32 float32
+		err = Unmarshal([]byte(input), &v32)
+		if err32 != nil {
+			assert.IsType(t, InvalidUnmarshalError(""), err, input)
+		} else if assert.Nil(t, err, input) {
+			assert.Equal(t, math.Float32bits(float32(expected32)), math.Float32bits(v32), input)
+		}
+	}
+}
+
+func TestDecoderFloatCorrectlyRoundedStream(t *testing.T) {
+	json := "[" + strings.Join(hardFloats, ", ") + "]"
+	var v []float64
+	dec := BorrowDecoder(iotest.OneByteReader(strings.NewReader(json)))
+	defer dec.Release()
+	err := dec.DecodeArray(DecodeArrayFunc(func(dec *Decoder) error {
+		var f float64
+		err := dec.Float64(&f)
+		v = append(v, f)
+		return err
+	}))
+	require.Nil(t, err, "err should be nil")
+	require.Len(t, v, len(hardFloats))
+	for i, input := range hardFloats {
+		expected, _ := strconv.ParseFloat(input, 64)
+		assert.Equal(t, math.Float64bits(expected), math.Float64bits(v[i]), input)
+	}
+}
+
+func TestDecoderFloatOverflow(t *testing.T) {
+	for _, input := range []string{"1.7976931348623159e308", "-1e309", "1e10000000000"} {
+		var v float64
+		err := Unmarshal([]byte(input), &v)
+		assert.IsType(t, InvalidUnmarshalError(""), err, input)
+	}
+	var v float32
+	err := Unmarshal([]byte("3.4028236e38"), &v)
+	assert.IsType(t, InvalidUnmarshalError(""), err, "float32 should overflow")
+}
+
+func TestEiselLemire(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	var n64, n32 int
+	const iterations = 100000
+	for i := 0; i < iterations; i++ {
+		mantissa := r.Uint64() >> uint(r.Intn(64))
+		exp10 := r.Intn(700) - 350
+		input := strconv.FormatUint(mantissa, 10) + "e" + strconv.Itoa(exp10)
+		if f, ok := eiselLemire64(mantissa, exp10); ok {
+			n64++
+			expected, _ := strconv.ParseFloat(input, 64)
+			require.Equal(t, math.Float64bits(expected), math.Float64bits(f), input)
+		}
+		exp10 %= 50
+		input = strconv.FormatUint(mantissa, 10) + "e" + strconv.Itoa(exp10)
+		if f, ok := eiselLemire32(mantissa, exp10); ok {
+			n32++
+			expected, _ := strconv.ParseFloat(input, 32)
+			require.Equal(t, math.Float32bits(float32(expected)), math.Float32bits(f), input)
+		}
+	}
+	// only subnormals, overflows and rare halfway cases should need the fallback
+	assert.True(t, n64 > iterations*8/10, "eiselLemire64 should decide most inputs")
+	assert.True(t, n32 > iterations*5/10, "eiselLemire32 should decide most inputs")
+}
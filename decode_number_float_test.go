@@ -94,13 +94,11 @@ func TestDecoderFloat64(t *testing.T) {
 			name:           "exp-err",
 			json:           "0e-20",
 			expectedResult: 0,
-			err:            true,
 		},
 		{
 			name:           "exp-err3",
 			json:           "-9e-60",
-			expectedResult: 0,
-			err:            true,
+			expectedResult: -9e-60,
 		},
 		{
 			name:       "exp-err4",
@@ -215,7 +213,6 @@ func TestDecoderFloat64(t *testing.T) {
 			name:           "basic-exp-too-big",
 			json:           "0e9223372036000000000 ",
 			expectedResult: 0,
-			err:            true,
 		},
 		{
 			name:           "big float",
@@ -425,15 +422,11 @@ func TestDecoderFloat64Null(t *testing.T) {
 			name:           "exp-err",
 			json:           "0e-20",
 			expectedResult: 0,
-			err:            true,
-			resultIsNil:    true,
 		},
 		{
 			name:           "exp-err3",
 			json:           "-9e-60",
-			expectedResult: 0,
-			err:            true,
-			resultIsNil:    true,
+			expectedResult: -9e-60,
 		},
 		{
 			name:        "exp-err4",
@@ -549,9 +542,7 @@ func TestDecoderFloat64Null(t *testing.T) {
 		{
 			name:           "basic-exp-too-big",
 			json:           "0e9223372036000000000 ",
-			expectedResult: 1,
-			err:            true,
-			resultIsNil:    true,
+			expectedResult: 0,
 		},
 		{
 			name:           "basic-exp-too-big",
@@ -702,13 +693,11 @@ func TestDecoderFloat32(t *testing.T) {
 			name:           "exp-err",
 			json:           "0e-20",
 			expectedResult: 0,
-			err:            true,
 		},
 		{
 			name:           "exp-err3",
 			json:           "-9e-60",
-			expectedResult: 0,
-			err:            true,
+			expectedResult: -9e-60,
 		},
 		{
 			name: "exp-err4",
@@ -1024,15 +1013,11 @@ func TestDecoderFloat32Null(t *testing.T) {
 			name:           "exp-err",
 			json:           "0e-20",
 			expectedResult: 0,
-			err:            true,
-			resultIsNil:    true,
 		},
 		{
 			name:           "exp-err3",
 			json:           "-9e-60",
-			expectedResult: 0,
-			err:            true,
-			resultIsNil:    true,
+			expectedResult: -9e-60,
 		},
 		{
 			name:        "exp-err4",
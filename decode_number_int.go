@@ -12,9 +12,15 @@ func (dec *Decoder) DecodeInt(v *int) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeInt(v)
 }
 func (dec *Decoder) decodeInt(v *int) error {
+	if dec.intPolicy != nil {
+		return decodeIntWithPolicy(dec, v, math.MinInt, math.MaxInt)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -42,15 +48,18 @@ func (dec *Decoder) decodeInt(v *int) error {
 				return err
 			}
 			return nil
+		case '"':
+			if !dec.quotedNumbers {
+				return dec.skipInvalidValue(v, dec.makeInvalidIntErr)
+			}
+			val, ok, err := dec.getQuotedInt(v, 0)
+			if err != nil || !ok {
+				return err
+			}
+			*v = int(val)
+			return nil
 		default:
-			dec.err = InvalidUnmarshalError(
-				fmt.Sprintf(
-					"Cannot unmarshall to int, wrong char '%s' found at pos %d",
-					string(dec.data[dec.cursor]),
-					dec.cursor,
-				),
-			)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidIntErr)
 			if err != nil {
 				return err
 			}
@@ -61,6 +70,9 @@ func (dec *Decoder) decodeInt(v *int) error {
 }
 
 func (dec *Decoder) decodeIntNull(v **int) error {
+	if dec.intPolicy != nil {
+		return decodeIntNullWithPolicy(dec, v, math.MinInt, math.MaxInt)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -94,15 +106,21 @@ func (dec *Decoder) decodeIntNull(v **int) error {
 				return err
 			}
 			return nil
+		case '"':
+			if !dec.quotedNumbers {
+				return dec.skipInvalidValue(v, dec.makeInvalidIntErr)
+			}
+			val, ok, err := dec.getQuotedInt(v, 0)
+			if err != nil || !ok {
+				return err
+			}
+			if *v == nil {
+				*v = new(int)
+			}
+			**v = int(val)
+			return nil
 		default:
-			dec.err = InvalidUnmarshalError(
-				fmt.Sprintf(
-					"Cannot unmarshall to int, wrong char '%s' found at pos %d",
-					string(dec.data[dec.cursor]),
-					dec.cursor,
-				),
-			)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidIntErr)
 			if err != nil {
 				return err
 			}
@@ -112,6 +130,21 @@ func (dec *Decoder) decodeIntNull(v **int) error {
 	return dec.raiseInvalidJSONErr(dec.cursor)
 }
 
+// makeInvalidIntErr returns the error for the value at the cursor which cannot be decoded to an int.
+// Without detailed errors, it names the wrong char and its position.
+func (dec *Decoder) makeInvalidIntErr(v interface{}) error {
+	if dec.detailed || dec.allErrors {
+		return dec.makeInvalidUnmarshalErr(v)
+	}
+	return InvalidUnmarshalError(
+		fmt.Sprintf(
+			"Cannot unmarshall to int, wrong char '%s' found at pos %d",
+			string(dec.data[dec.cursor]),
+			dec.cursor,
+		),
+	)
+}
+
 // DecodeInt16 reads the next JSON-encoded value from the decoder's input (io.Reader) and stores it in the int16 pointed to by v.
 //
 // See the documentation for Unmarshal for details about the conversion of JSON into a Go value.
@@ -119,9 +152,15 @@ func (dec *Decoder) DecodeInt16(v *int16) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeInt16(v)
 }
 func (dec *Decoder) decodeInt16(v *int16) error {
+	if dec.intPolicy != nil {
+		return decodeIntWithPolicy(dec, v, math.MinInt16, math.MaxInt16)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -149,9 +188,15 @@ func (dec *Decoder) decodeInt16(v *int16) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedInt(v, 16)
+			if err != nil || !ok {
+				return err
+			}
+			*v = int16(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -161,6 +206,9 @@ func (dec *Decoder) decodeInt16(v *int16) error {
 	return dec.raiseInvalidJSONErr(dec.cursor)
 }
 func (dec *Decoder) decodeInt16Null(v **int16) error {
+	if dec.intPolicy != nil {
+		return decodeIntNullWithPolicy(dec, v, math.MinInt16, math.MaxInt16)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -194,9 +242,18 @@ func (dec *Decoder) decodeInt16Null(v **int16) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedInt(v, 16)
+			if err != nil || !ok {
+				return err
+			}
+			if *v == nil {
+				*v = new(int16)
+			}
+			**v = int16(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -275,7 +332,7 @@ func (dec *Decoder) getInt16() (int16, error) {
 					}
 					val := floatVal * float64(pow10uint64[pExp])
 					return int16(val), nil
-				case ' ', '\t', '\n', ',', ']', '}':
+				case ' ', '\t', '\n', '\r', ',', ']', '}':
 					dec.cursor = j
 					return dec.atoi16(start, end), nil
 				default:
@@ -316,7 +373,7 @@ func (dec *Decoder) getInt16WithExp(init int16) (int16, error) {
 				case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 					uintv := uint16(digits[dec.data[dec.cursor]])
 					exp = (exp << 3) + (exp << 1) + uintv
-				case ' ', '\t', '\n', '}', ',', ']':
+				case ' ', '\t', '\n', '\r', '}', ',', ']':
 					exp = exp + 1
 					if exp >= uint16(len(pow10uint64)) {
 						return 0, dec.raiseInvalidJSONErr(dec.cursor)
@@ -351,9 +408,15 @@ func (dec *Decoder) DecodeInt8(v *int8) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeInt8(v)
 }
 func (dec *Decoder) decodeInt8(v *int8) error {
+	if dec.intPolicy != nil {
+		return decodeIntWithPolicy(dec, v, math.MinInt8, math.MaxInt8)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -381,9 +444,15 @@ func (dec *Decoder) decodeInt8(v *int8) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedInt(v, 8)
+			if err != nil || !ok {
+				return err
+			}
+			*v = int8(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -393,6 +462,9 @@ func (dec *Decoder) decodeInt8(v *int8) error {
 	return dec.raiseInvalidJSONErr(dec.cursor)
 }
 func (dec *Decoder) decodeInt8Null(v **int8) error {
+	if dec.intPolicy != nil {
+		return decodeIntNullWithPolicy(dec, v, math.MinInt8, math.MaxInt8)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -426,9 +498,18 @@ func (dec *Decoder) decodeInt8Null(v **int8) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedInt(v, 8)
+			if err != nil || !ok {
+				return err
+			}
+			if *v == nil {
+				*v = new(int8)
+			}
+			**v = int8(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -507,7 +588,7 @@ func (dec *Decoder) getInt8() (int8, error) {
 					}
 					val := floatVal * float64(pow10uint64[pExp])
 					return int8(val), nil
-				case ' ', '\t', '\n', ',', ']', '}':
+				case ' ', '\t', '\n', '\r', ',', ']', '}':
 					dec.cursor = j
 					return dec.atoi8(start, end), nil
 				default:
@@ -548,7 +629,7 @@ func (dec *Decoder) getInt8WithExp(init int8) (int8, error) {
 				case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 					uintv := uint8(digits[dec.data[dec.cursor]])
 					exp = (exp << 3) + (exp << 1) + uintv
-				case ' ', '\t', '\n', '}', ',', ']':
+				case ' ', '\t', '\n', '\r', '}', ',', ']':
 					if exp+1 >= uint8(len(pow10uint64)) {
 						return 0, dec.raiseInvalidJSONErr(dec.cursor)
 					}
@@ -582,9 +663,15 @@ func (dec *Decoder) DecodeInt32(v *int32) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeInt32(v)
 }
 func (dec *Decoder) decodeInt32(v *int32) error {
+	if dec.intPolicy != nil {
+		return decodeIntWithPolicy(dec, v, math.MinInt32, math.MaxInt32)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -611,9 +698,15 @@ func (dec *Decoder) decodeInt32(v *int32) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedInt(v, 32)
+			if err != nil || !ok {
+				return err
+			}
+			*v = int32(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -623,6 +716,9 @@ func (dec *Decoder) decodeInt32(v *int32) error {
 	return dec.raiseInvalidJSONErr(dec.cursor)
 }
 func (dec *Decoder) decodeInt32Null(v **int32) error {
+	if dec.intPolicy != nil {
+		return decodeIntNullWithPolicy(dec, v, math.MinInt32, math.MaxInt32)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -655,9 +751,18 @@ func (dec *Decoder) decodeInt32Null(v **int32) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedInt(v, 32)
+			if err != nil || !ok {
+				return err
+			}
+			if *v == nil {
+				*v = new(int32)
+			}
+			**v = int32(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -737,7 +842,7 @@ func (dec *Decoder) getInt32() (int32, error) {
 					}
 					val := floatVal * float64(pow10uint64[pExp])
 					return int32(val), nil
-				case ' ', '\t', '\n', ',', ']', '}':
+				case ' ', '\t', '\n', '\r', ',', ']', '}':
 					dec.cursor = j
 					return dec.atoi32(start, end), nil
 				default:
@@ -778,7 +883,7 @@ func (dec *Decoder) getInt32WithExp(init int32) (int32, error) {
 				case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 					uintv := uint32(digits[dec.data[dec.cursor]])
 					exp = (exp << 3) + (exp << 1) + uintv
-				case ' ', '\t', '\n', '}', ',', ']':
+				case ' ', '\t', '\n', '\r', '}', ',', ']':
 					if exp+1 >= uint32(len(pow10uint64)) {
 						return 0, dec.raiseInvalidJSONErr(dec.cursor)
 					}
@@ -812,10 +917,16 @@ func (dec *Decoder) DecodeInt64(v *int64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeInt64(v)
 }
 
 func (dec *Decoder) decodeInt64(v *int64) error {
+	if dec.intPolicy != nil {
+		return decodeIntWithPolicy(dec, v, math.MinInt64, math.MaxInt64)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -842,9 +953,15 @@ func (dec *Decoder) decodeInt64(v *int64) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedInt(v, 64)
+			if err != nil || !ok {
+				return err
+			}
+			*v = val
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -854,6 +971,9 @@ func (dec *Decoder) decodeInt64(v *int64) error {
 	return dec.raiseInvalidJSONErr(dec.cursor)
 }
 func (dec *Decoder) decodeInt64Null(v **int64) error {
+	if dec.intPolicy != nil {
+		return decodeIntNullWithPolicy(dec, v, math.MinInt64, math.MaxInt64)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -886,9 +1006,18 @@ func (dec *Decoder) decodeInt64Null(v **int64) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedInt(v, 64)
+			if err != nil || !ok {
+				return err
+			}
+			if *v == nil {
+				*v = new(int64)
+			}
+			**v = val
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -920,7 +1049,7 @@ func (dec *Decoder) getInt64() (int64, error) {
 		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 			end = j
 			continue
-		case ' ', '\t', '\n', ',', '}', ']':
+		case ' ', '\t', '\n', '\r', ',', '}', ']':
 			dec.cursor = j
 			return dec.atoi64(start, end), nil
 		case '.':
@@ -971,7 +1100,7 @@ func (dec *Decoder) getInt64() (int64, error) {
 					}
 					val := floatVal * float64(pow10uint64[pExp])
 					return int64(val), nil
-				case ' ', '\t', '\n', ',', ']', '}':
+				case ' ', '\t', '\n', '\r', ',', ']', '}':
 					dec.cursor = j
 					return dec.atoi64(start, end), nil
 				default:
@@ -1009,7 +1138,7 @@ func (dec *Decoder) getInt64WithExp(init int64) (int64, error) {
 				case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 					uintv := uint64(digits[dec.data[dec.cursor]])
 					exp = (exp << 3) + (exp << 1) + uintv
-				case ' ', '\t', '\n', '}', ',', ']':
+				case ' ', '\t', '\n', '\r', '}', ',', ']':
 					if exp+1 >= uint64(len(pow10uint64)) {
 						return 0, dec.raiseInvalidJSONErr(dec.cursor)
 					}
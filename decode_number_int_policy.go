@@ -0,0 +1,258 @@
+package gojay
+
+import "math"
+
+// IntPolicy configures how the Decoder converts JSON numbers to the integer types, from int8 to uint64.
+// See Decoder.SetIntPolicy.
+//
+// Its zero value accepts only integers written without a fraction or an exponent and within the range of the type,
+// other numbers get an InvalidUnmarshalError. Numbers with a fractional part, like 1.5, always get one.
+type IntPolicy struct {
+	// Clamp sets numbers out of the range of the type to its minimum or maximum,
+	// like 300 to 255 and -1 to 0 for a uint8, instead of returning an InvalidUnmarshalError.
+	Clamp bool
+	// IntegralFloats accepts numbers written with a fraction or an exponent when their value is an integer,
+	// like 3.0, 1e3 or 1.5e1, instead of returning an InvalidUnmarshalError.
+	IntegralFloats bool
+}
+
+// IntegerPolicy returns a DecoderOption setting how numbers are converted to integers.
+// See Decoder.SetIntPolicy.
+func IntegerPolicy(p IntPolicy) DecoderOption {
+	return func(dec *Decoder) {
+		dec.SetIntPolicy(p)
+	}
+}
+
+// SetIntPolicy sets how the Decoder converts numbers to int, int8, int16, int32, int64, uint8, uint16, uint32 and uint64,
+// including quoted numbers if they are accepted (see Decoder.UseQuotedNumbers).
+// Numbers are converted exactly, whatever their number of digits or their exponent.
+// When a number is rejected, an InvalidUnmarshalError is returned and the value is not changed.
+//
+// Without a policy, the Decoder keeps its historical conversions: fractions are truncated, so 1.5 gives 1,
+// and numbers out of the range of the type give 0 and an InvalidUnmarshalError, except for some numbers
+// with an exponent which may wrap around. Numbers with an exponent are rejected for unsigned types.
+func (dec *Decoder) SetIntPolicy(p IntPolicy) {
+	dec.intPolicy = &p
+}
+
+// decodeIntWithPolicy decodes the next value to v following the IntPolicy of the Decoder.
+func decodeIntWithPolicy[T int | int8 | int16 | int32 | int64](dec *Decoder, v *T, min, max int64) error {
+	val, ok, err := dec.getIntWithPolicy(v, min, max)
+	if err != nil || !ok {
+		return err
+	}
+	*v = T(val)
+	return nil
+}
+
+// decodeIntNullWithPolicy is like decodeIntWithPolicy, it allocates *v if a number is decoded.
+func decodeIntNullWithPolicy[T int | int8 | int16 | int32 | int64](dec *Decoder, v **T, min, max int64) error {
+	val, ok, err := dec.getIntWithPolicy(v, min, max)
+	if err != nil || !ok {
+		return err
+	}
+	if *v == nil {
+		*v = new(T)
+	}
+	**v = T(val)
+	return nil
+}
+
+// decodeUintWithPolicy decodes the next value to v following the IntPolicy of the Decoder.
+func decodeUintWithPolicy[T uint8 | uint16 | uint32 | uint64](dec *Decoder, v *T, max uint64) error {
+	val, ok, err := dec.getUintWithPolicy(v, max)
+	if err != nil || !ok {
+		return err
+	}
+	*v = T(val)
+	return nil
+}
+
+// decodeUintNullWithPolicy is like decodeUintWithPolicy, it allocates *v if a number is decoded.
+func decodeUintNullWithPolicy[T uint8 | uint16 | uint32 | uint64](dec *Decoder, v **T, max uint64) error {
+	val, ok, err := dec.getUintWithPolicy(v, max)
+	if err != nil || !ok {
+		return err
+	}
+	if *v == nil {
+		*v = new(T)
+	}
+	**v = T(val)
+	return nil
+}
+
+// getIntWithPolicy converts the next value to an integer within [min, max].
+// It returns false if the value is null or rejected, the error being recorded.
+func (dec *Decoder) getIntWithPolicy(v interface{}, min, max int64) (int64, bool, error) {
+	lit, start, err := dec.getIntLiteral(v)
+	if lit == nil {
+		return 0, false, err
+	}
+	mag, neg, conv := dec.parseIntLiteral(lit)
+	switch {
+	case conv == intRejected:
+		dec.setInvalidUnmarshalErr(v, start)
+		return 0, false, nil
+	case neg && (conv == intOverflow || mag > uint64(-(min+1))+1):
+		if !dec.intPolicy.Clamp {
+			dec.setInvalidUnmarshalErr(v, start)
+			return 0, false, nil
+		}
+		return min, true, nil
+	case neg:
+		return int64(-mag), true, nil
+	case conv == intOverflow || mag > uint64(max):
+		if !dec.intPolicy.Clamp {
+			dec.setInvalidUnmarshalErr(v, start)
+			return 0, false, nil
+		}
+		return max, true, nil
+	}
+	return int64(mag), true, nil
+}
+
+// getUintWithPolicy converts the next value to an unsigned integer lower or equal to max.
+// It returns false if the value is null or rejected, the error being recorded.
+func (dec *Decoder) getUintWithPolicy(v interface{}, max uint64) (uint64, bool, error) {
+	lit, start, err := dec.getIntLiteral(v)
+	if lit == nil {
+		return 0, false, err
+	}
+	mag, neg, conv := dec.parseIntLiteral(lit)
+	switch {
+	case conv == intRejected:
+		dec.setInvalidUnmarshalErr(v, start)
+		return 0, false, nil
+	case neg && (conv == intOverflow || mag != 0):
+		if !dec.intPolicy.Clamp {
+			dec.setInvalidUnmarshalErr(v, start)
+			return 0, false, nil
+		}
+		return 0, true, nil
+	case conv == intOverflow || mag > max:
+		if !dec.intPolicy.Clamp {
+			dec.setInvalidUnmarshalErr(v, start)
+			return 0, false, nil
+		}
+		return max, true, nil
+	}
+	return mag, true, nil
+}
+
+// getIntLiteral returns the literal of the next number, which may be quoted if quoted numbers are accepted,
+// and the position of the value. It returns a nil slice if the value is null or is not a number,
+// the error being recorded.
+func (dec *Decoder) getIntLiteral(v interface{}) ([]byte, int, error) {
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		switch dec.data[dec.cursor] {
+		case ' ', '\n', '\t', '\r', ',':
+			continue
+		case '"':
+			start := dec.cursor
+			lit, err := dec.getQuotedLiteral(v)
+			return lit, start, err
+		}
+		return dec.getNumberLiteral(v)
+	}
+	return nil, 0, dec.raiseInvalidJSONErr(dec.cursor)
+}
+
+// intConversion is the outcome of parseIntLiteral.
+type intConversion byte
+
+const (
+	intExact intConversion = iota
+	// intOverflow is for absolute values above math.MaxUint64.
+	intOverflow
+	// intRejected is for numbers with a fractional part,
+	// and for numbers with a fraction or an exponent if they are not accepted.
+	intRejected
+)
+
+// parseIntLiteral returns the absolute value of the valid JSON number lit and whether it is negative.
+func (dec *Decoder) parseIntLiteral(lit []byte) (uint64, bool, intConversion) {
+	var neg bool
+	i := 0
+	if lit[0] == '-' {
+		neg = true
+		i++
+	}
+	start := i
+	for i < len(lit) && isDigit(lit[i]) {
+		i++
+	}
+	intPart := lit[start:i]
+	var fracPart []byte
+	var exp int
+	if i < len(lit) {
+		if !dec.intPolicy.IntegralFloats {
+			return 0, neg, intRejected
+		}
+		if lit[i] == '.' {
+			i++
+			start = i
+			for i < len(lit) && isDigit(lit[i]) {
+				i++
+			}
+			fracPart = lit[start:i]
+		}
+		if i < len(lit) {
+			// exponent, larger ones overflow anyway
+			i++
+			var expNeg bool
+			switch lit[i] {
+			case '-':
+				expNeg = true
+				i++
+			case '+':
+				i++
+			}
+			for ; i < len(lit); i++ {
+				if exp < 10000 {
+					exp = exp*10 + int(lit[i]-'0')
+				}
+			}
+			if expNeg {
+				exp = -exp
+			}
+		}
+	}
+	// the value is the digits of both parts times 10^exp
+	exp -= len(fracPart)
+	digitAt := func(k int) byte {
+		if k < len(intPart) {
+			return intPart[k]
+		}
+		return fracPart[k-len(intPart)]
+	}
+	n := len(intPart) + len(fracPart)
+	if exp < 0 {
+		// the digits moved after the decimal point must be zeros
+		cut := n + exp
+		if cut < 0 {
+			cut = 0
+		}
+		for k := cut; k < n; k++ {
+			if digitAt(k) != '0' {
+				return 0, neg, intRejected
+			}
+		}
+		n, exp = cut, 0
+	}
+	var mag uint64
+	for k := 0; k < n; k++ {
+		d := uint64(digitAt(k) - '0')
+		if mag > (math.MaxUint64-d)/10 {
+			return 0, neg, intOverflow
+		}
+		mag = mag*10 + d
+	}
+	for ; exp > 0 && mag != 0; exp-- {
+		if mag > math.MaxUint64/10 {
+			return 0, neg, intOverflow
+		}
+		mag *= 10
+	}
+	return mag, neg, intExact
+}
@@ -0,0 +1,189 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoderIntPolicy(t *testing.T) {
+	strict := IntPolicy{}
+	clamp := IntPolicy{Clamp: true}
+	floats := IntPolicy{IntegralFloats: true}
+	both := IntPolicy{Clamp: true, IntegralFloats: true}
+	testCases := []struct {
+		name     string
+		json     string
+		policy   IntPolicy
+		v        interface{}
+		expected interface{}
+		err      bool
+	}{
+		{name: "int8", json: `-128`, policy: strict, v: new(int8), expected: int8(-128)},
+		{name: "int8-overflow", json: `128`, policy: strict, v: new(int8), expected: int8(0), err: true},
+		{name: "int8-underflow", json: `-129`, policy: strict, v: new(int8), expected: int8(0), err: true},
+		{name: "int8-clamp-max", json: `300`, policy: clamp, v: new(int8), expected: int8(127)},
+		{name: "int8-clamp-min", json: `-300`, policy: clamp, v: new(int8), expected: int8(-128)},
+		{name: "int8-exponent", json: `1e3`, policy: strict, v: new(int8), expected: int8(0), err: true},
+		{name: "int8-exponent-overflow", json: `1e3`, policy: floats, v: new(int8), expected: int8(0), err: true},
+		{name: "int8-exponent-clamp", json: `1e3`, policy: both, v: new(int8), expected: int8(127)},
+		{name: "int8-fraction", json: `1.5`, policy: both, v: new(int8), expected: int8(0), err: true},
+		{name: "int16", json: `32767`, policy: strict, v: new(int16), expected: int16(32767)},
+		{name: "int16-clamp", json: `-1e10`, policy: both, v: new(int16), expected: int16(-32768)},
+		{name: "int32", json: `-2147483648`, policy: strict, v: new(int32), expected: int32(-2147483648)},
+		{name: "int32-overflow", json: `2147483648`, policy: strict, v: new(int32), expected: int32(0), err: true},
+		{name: "int32-float", json: `-2.147483648e9`, policy: floats, v: new(int32), expected: int32(-2147483648)},
+		{name: "int", json: `3.0`, policy: floats, v: new(int), expected: 3},
+		{name: "int-float-rejected", json: `3.0`, policy: clamp, v: new(int), expected: 0, err: true},
+		{name: "int64", json: `-9223372036854775808`, policy: strict, v: new(int64), expected: int64(-9223372036854775808)},
+		{name: "int64-overflow", json: `9223372036854775808`, policy: strict, v: new(int64), expected: int64(0), err: true},
+		{name: "int64-clamp", json: `99999999999999999999999`, policy: clamp, v: new(int64), expected: int64(9223372036854775807)},
+		{name: "int64-clamp-min", json: `-99999999999999999999999`, policy: clamp, v: new(int64), expected: int64(-9223372036854775808)},
+		{name: "int64-exponent", json: `1E3`, policy: floats, v: new(int64), expected: int64(1000)},
+		{name: "int64-exponent-plus", json: `12e+2`, policy: floats, v: new(int64), expected: int64(1200)},
+		{name: "int64-mantissa", json: `1.5e1`, policy: floats, v: new(int64), expected: int64(15)},
+		{name: "int64-negative-exponent", json: `1500e-2`, policy: floats, v: new(int64), expected: int64(15)},
+		{name: "int64-negative-exponent-fraction", json: `1501e-2`, policy: floats, v: new(int64), expected: int64(0), err: true},
+		{name: "int64-small", json: `1e-400`, policy: both, v: new(int64), expected: int64(0), err: true},
+		{name: "int64-zero-exponent", json: `0e999999999999`, policy: floats, v: new(int64), expected: int64(0)},
+		{name: "int64-huge-exponent", json: `1e999999999999`, policy: both, v: new(int64), expected: int64(9223372036854775807)},
+		{name: "int64-trailing-zeros", json: `9223372036854775807.000000000000000000000`, policy: floats, v: new(int64), expected: int64(9223372036854775807)},
+		{name: "int64-precision", json: `9007199254740993.0`, policy: floats, v: new(int64), expected: int64(9007199254740993)},
+		{name: "int64-negative-zero", json: `-0.0`, policy: floats, v: new(int64), expected: int64(0)},
+		{name: "uint8", json: `255`, policy: strict, v: new(uint8), expected: uint8(255)},
+		{name: "uint8-overflow", json: `256`, policy: strict, v: new(uint8), expected: uint8(0), err: true},
+		{name: "uint8-clamp", json: `256`, policy: clamp, v: new(uint8), expected: uint8(255)},
+		{name: "uint8-negative", json: `-1`, policy: strict, v: new(uint8), expected: uint8(0), err: true},
+		{name: "uint8-negative-clamp", json: `-1`, policy: clamp, v: new(uint8), expected: uint8(0)},
+		{name: "uint8-negative-zero", json: `-0`, policy: strict, v: new(uint8), expected: uint8(0)},
+		{name: "uint16", json: `6.5535e4`, policy: floats, v: new(uint16), expected: uint16(65535)},
+		{name: "uint16-fraction", json: `0.5`, policy: both, v: new(uint16), expected: uint16(0), err: true},
+		{name: "uint32", json: `4294967295`, policy: strict, v: new(uint32), expected: uint32(4294967295)},
+		{name: "uint32-clamp", json: `4294967296`, policy: clamp, v: new(uint32), expected: uint32(4294967295)},
+		{name: "uint64", json: `18446744073709551615`, policy: strict, v: new(uint64), expected: uint64(18446744073709551615)},
+		{name: "uint64-overflow", json: `18446744073709551616`, policy: strict, v: new(uint64), expected: uint64(0), err: true},
+		{name: "uint64-clamp", json: `1.8446744073709551616e19`, policy: both, v: new(uint64), expected: uint64(18446744073709551615)},
+		{name: "uint64-exponent", json: `1e19`, policy: floats, v: new(uint64), expected: uint64(10000000000000000000)},
+		{name: "uint64-negative-clamp", json: `-1e30`, policy: both, v: new(uint64), expected: uint64(0)},
+		{name: "string", json: `"1"`, policy: both, v: new(int64), expected: int64(0), err: true},
+		{name: "null", json: `null`, policy: strict, v: new(int32), expected: int32(0)},
+		{name: "invalid", json: `1.`, policy: floats, v: new(int64), expected: int64(0), err: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := UnmarshalWithOptions([]byte(testCase.json), testCase.v, IntegerPolicy(testCase.policy))
+			if testCase.err {
+				require.NotNil(t, err, "err should not be nil")
+			} else {
+				require.Nil(t, err, "err should be nil")
+			}
+			assert.Equal(t, testCase.expected, deref(testCase.v))
+		})
+	}
+}
+
+func TestDecoderIntPolicyRejectedValueUnchanged(t *testing.T) {
+	v := int8(42)
+	err := UnmarshalWithOptions([]byte(`1000`), &v, IntegerPolicy(IntPolicy{}))
+	require.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+	assert.Equal(t, int8(42), v, "v should not be changed")
+
+	u := uint64(42)
+	err = UnmarshalWithOptions([]byte(`1.5`), &u, IntegerPolicy(IntPolicy{IntegralFloats: true}))
+	require.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+	assert.Equal(t, uint64(42), u, "v should not be changed")
+}
+
+func TestDecoderIntPolicyQuoted(t *testing.T) {
+	var v uint32
+	err := UnmarshalWithOptions([]byte(`"4e9"`), &v, IntegerPolicy(IntPolicy{IntegralFloats: true}), QuotedNumbers())
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, uint32(4000000000), v)
+
+	err = UnmarshalWithOptions([]byte(`"5e9"`), &v, IntegerPolicy(IntPolicy{}), QuotedNumbers())
+	require.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+
+	err = UnmarshalWithOptions([]byte(`"x"`), &v, IntegerPolicy(IntPolicy{}), QuotedNumbers())
+	require.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+}
+
+type testIntPolicyObject struct {
+	i8  *int8
+	i   *int
+	u16 *uint16
+	u64 *uint64
+}
+
+func (t *testIntPolicyObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "i8":
+		return dec.Int8Null(&t.i8)
+	case "i":
+		return dec.IntNull(&t.i)
+	case "u16":
+		return dec.Uint16Null(&t.u16)
+	case "u64":
+		return dec.Uint64Null(&t.u64)
+	}
+	return nil
+}
+
+func (t *testIntPolicyObject) NKeys() int {
+	return 4
+}
+
+func TestDecoderIntPolicyNull(t *testing.T) {
+	json := `{"i8":-1e3,"i":2.0,"u16":null,"u64":-5}`
+	v := &testIntPolicyObject{}
+	dec := NewDecoder(strings.NewReader(json))
+	dec.SetIntPolicy(IntPolicy{Clamp: true, IntegralFloats: true})
+	err := dec.Decode(v)
+	require.Nil(t, err, "err should be nil")
+	require.NotNil(t, v.i8, "i8 should be allocated")
+	assert.Equal(t, int8(-128), *v.i8)
+	require.NotNil(t, v.i, "i should be allocated")
+	assert.Equal(t, 2, *v.i)
+	assert.Nil(t, v.u16, "u16 should be nil")
+	require.NotNil(t, v.u64, "u64 should be allocated")
+	assert.Equal(t, uint64(0), *v.u64)
+
+	v = &testIntPolicyObject{}
+	dec = NewDecoder(strings.NewReader(`{"i":2.5,"u16":1}`))
+	dec.SetIntPolicy(IntPolicy{IntegralFloats: true})
+	err = dec.Decode(v)
+	require.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+	assert.Nil(t, v.i, "i should not be allocated")
+	require.NotNil(t, v.u16, "the next keys should be decoded")
+	assert.Equal(t, uint16(1), *v.u16)
+}
+
+func TestDecoderIntPolicyStream(t *testing.T) {
+	json := `[1e2, 3.000, -7, 1e30, 42]`
+	var v []int16
+	dec := BorrowDecoder(iotest.OneByteReader(strings.NewReader(json)))
+	defer dec.Release()
+	dec.SetIntPolicy(IntPolicy{Clamp: true, IntegralFloats: true})
+	err := dec.DecodeArray(DecodeArrayFunc(func(dec *Decoder) error {
+		var i int16
+		err := dec.Int16(&i)
+		v = append(v, i)
+		return err
+	}))
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, []int16{100, 3, -7, 32767, 42}, v)
+}
+
+func TestDecoderIntPolicyReset(t *testing.T) {
+	dec := BorrowDecoder(nil)
+	dec.SetIntPolicy(IntPolicy{Clamp: true})
+	dec.Release()
+	dec = BorrowDecoder(strings.NewReader(`1.9`))
+	defer dec.Release()
+	var v int64
+	err := dec.Decode(&v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, int64(1), v, "the historical conversion should be used without a policy")
+}
@@ -308,6 +308,18 @@ func TestDecoderInt(t *testing.T) {
 		assert.NotNil(t, err, "Err must not be nil")
 		assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
 	})
+	t.Run("error-message", func(t *testing.T) {
+		var v int
+		err := Unmarshal([]byte(` true`), &v)
+		assert.Equal(t, InvalidUnmarshalError("Cannot unmarshall to int, wrong char 't' found at pos 1"), err)
+		err = Unmarshal([]byte(`"1"`), &v)
+		assert.Equal(t, InvalidUnmarshalError(`Cannot unmarshall to int, wrong char '"' found at pos 0`), err)
+		var p *int
+		err = Unmarshal([]byte(`{}`), &p)
+		assert.Equal(t, InvalidUnmarshalError("Cannot unmarshall to int, wrong char '{' found at pos 0"), err)
+		err = UnmarshalWithOptions([]byte(`true`), &v, DetailedErrors())
+		assert.IsType(t, &DecodeError{}, err, "err should be a *DecodeError")
+	})
 }
 func TestDecoderIntNull(t *testing.T) {
 	testCases := []struct {
@@ -0,0 +1,268 @@
+package gojay
+
+import (
+	"strconv"
+	"unsafe"
+)
+
+// QuotedNumbers returns a DecoderOption accepting numbers written as strings.
+// See Decoder.UseQuotedNumbers.
+func QuotedNumbers() DecoderOption {
+	return func(dec *Decoder) {
+		dec.UseQuotedNumbers()
+	}
+}
+
+// UseQuotedNumbers causes the Decoder to accept strings holding a number, like `"9007199254740993"`,
+// when decoding integers and floats, as encoding/json does for fields with the `,string` option.
+// The string must hold a number of the decoded type: a fraction or an exponent is not accepted for integers,
+// nor a value overflowing the type. Otherwise an InvalidUnmarshalError is returned.
+//
+// Without it, a string makes decoding a number return an InvalidUnmarshalError.
+func (dec *Decoder) UseQuotedNumbers() {
+	dec.quotedNumbers = true
+}
+
+// getQuotedLiteral returns the content of the string at the cursor if it holds a JSON number,
+// or records an InvalidUnmarshalError for v and returns a nil slice.
+// If quoted numbers are not accepted, the string is skipped and an InvalidUnmarshalError is recorded.
+func (dec *Decoder) getQuotedLiteral(v interface{}) ([]byte, error) {
+	start := dec.cursor
+	if !dec.quotedNumbers {
+		if err := dec.skipData(); err != nil {
+			return nil, err
+		}
+		dec.setInvalidUnmarshalErr(v, start)
+		return nil, nil
+	}
+	dec.cursor++
+	strStart, strEnd, err := dec.getString()
+	if err != nil {
+		return nil, err
+	}
+	dec.cursor = strEnd
+	// we do minus one to remove the last quote
+	lit := dec.data[strStart : strEnd-1]
+	if !isNumberLiteral(lit) {
+		dec.setInvalidUnmarshalErr(v, start)
+		return nil, nil
+	}
+	return lit, nil
+}
+
+// getQuotedInt parses the string at the cursor as an integer of bitSize bits, 0 being the size of int.
+// It returns false if the string is not accepted, the error being recorded.
+func (dec *Decoder) getQuotedInt(v interface{}, bitSize int) (int64, bool, error) {
+	start := dec.cursor
+	lit, err := dec.getQuotedLiteral(v)
+	if lit == nil {
+		return 0, false, err
+	}
+	val, err := strconv.ParseInt(*(*string)(unsafe.Pointer(&lit)), 10, bitSize)
+	if err != nil {
+		dec.setInvalidUnmarshalErr(v, start)
+		return 0, false, nil
+	}
+	return val, true, nil
+}
+
+// getQuotedUint parses the string at the cursor as an unsigned integer of bitSize bits.
+// It returns false if the string is not accepted, the error being recorded.
+func (dec *Decoder) getQuotedUint(v interface{}, bitSize int) (uint64, bool, error) {
+	start := dec.cursor
+	lit, err := dec.getQuotedLiteral(v)
+	if lit == nil {
+		return 0, false, err
+	}
+	val, err := strconv.ParseUint(*(*string)(unsafe.Pointer(&lit)), 10, bitSize)
+	if err != nil {
+		dec.setInvalidUnmarshalErr(v, start)
+		return 0, false, nil
+	}
+	return val, true, nil
+}
+
+// getQuotedFloat parses the string at the cursor as a float of bitSize bits.
+// It returns false if the string is not accepted, the error being recorded.
+func (dec *Decoder) getQuotedFloat(v interface{}, bitSize int) (float64, bool, error) {
+	start := dec.cursor
+	lit, err := dec.getQuotedLiteral(v)
+	if lit == nil {
+		return 0, false, err
+	}
+	val, err := strconv.ParseFloat(*(*string)(unsafe.Pointer(&lit)), bitSize)
+	if err != nil {
+		dec.setInvalidUnmarshalErr(v, start)
+		return 0, false, nil
+	}
+	return val, true, nil
+}
+
+// withQuotedNumbers runs decode accepting quoted numbers, whatever the option of the Decoder.
+func (dec *Decoder) withQuotedNumbers(decode func() error) error {
+	quoted := dec.quotedNumbers
+	dec.quotedNumbers = true
+	err := decode()
+	dec.quotedNumbers = quoted
+	if err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// Add Values functions
+
+// AddIntString decodes the JSON value within an object or an array to an *int.
+// The value may be a string holding the number, like `"123"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) AddIntString(v *int) error {
+	return dec.IntString(v)
+}
+
+// IntString decodes the JSON value within an object or an array to an *int.
+// The value may be a string holding the number, like `"123"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) IntString(v *int) error {
+	return dec.withQuotedNumbers(func() error {
+		return dec.decodeInt(v)
+	})
+}
+
+// AddInt8String decodes the JSON value within an object or an array to an *int8.
+// The value may be a string holding the number, like `"-128"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) AddInt8String(v *int8) error {
+	return dec.Int8String(v)
+}
+
+// Int8String decodes the JSON value within an object or an array to an *int8.
+// The value may be a string holding the number, like `"-128"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) Int8String(v *int8) error {
+	return dec.withQuotedNumbers(func() error {
+		return dec.decodeInt8(v)
+	})
+}
+
+// AddInt16String decodes the JSON value within an object or an array to an *int16.
+// The value may be a string holding the number, like `"123"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) AddInt16String(v *int16) error {
+	return dec.Int16String(v)
+}
+
+// Int16String decodes the JSON value within an object or an array to an *int16.
+// The value may be a string holding the number, like `"123"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) Int16String(v *int16) error {
+	return dec.withQuotedNumbers(func() error {
+		return dec.decodeInt16(v)
+	})
+}
+
+// AddInt32String decodes the JSON value within an object or an array to an *int32.
+// The value may be a string holding the number, like `"123"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) AddInt32String(v *int32) error {
+	return dec.Int32String(v)
+}
+
+// Int32String decodes the JSON value within an object or an array to an *int32.
+// The value may be a string holding the number, like `"123"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) Int32String(v *int32) error {
+	return dec.withQuotedNumbers(func() error {
+		return dec.decodeInt32(v)
+	})
+}
+
+// AddInt64String decodes the JSON value within an object or an array to an *int64.
+// The value may be a string holding the number, like `"9007199254740993"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) AddInt64String(v *int64) error {
+	return dec.Int64String(v)
+}
+
+// Int64String decodes the JSON value within an object or an array to an *int64.
+// The value may be a string holding the number, like `"9007199254740993"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) Int64String(v *int64) error {
+	return dec.withQuotedNumbers(func() error {
+		return dec.decodeInt64(v)
+	})
+}
+
+// AddUint8String decodes the JSON value within an object or an array to a *uint8.
+// The value may be a string holding the number, like `"255"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) AddUint8String(v *uint8) error {
+	return dec.Uint8String(v)
+}
+
+// Uint8String decodes the JSON value within an object or an array to a *uint8.
+// The value may be a string holding the number, like `"255"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) Uint8String(v *uint8) error {
+	return dec.withQuotedNumbers(func() error {
+		return dec.decodeUint8(v)
+	})
+}
+
+// AddUint16String decodes the JSON value within an object or an array to a *uint16.
+// The value may be a string holding the number, like `"123"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) AddUint16String(v *uint16) error {
+	return dec.Uint16String(v)
+}
+
+// Uint16String decodes the JSON value within an object or an array to a *uint16.
+// The value may be a string holding the number, like `"123"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) Uint16String(v *uint16) error {
+	return dec.withQuotedNumbers(func() error {
+		return dec.decodeUint16(v)
+	})
+}
+
+// AddUint32String decodes the JSON value within an object or an array to a *uint32.
+// The value may be a string holding the number, like `"123"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) AddUint32String(v *uint32) error {
+	return dec.Uint32String(v)
+}
+
+// Uint32String decodes the JSON value within an object or an array to a *uint32.
+// The value may be a string holding the number, like `"123"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) Uint32String(v *uint32) error {
+	return dec.withQuotedNumbers(func() error {
+		return dec.decodeUint32(v)
+	})
+}
+
+// AddUint64String decodes the JSON value within an object or an array to a *uint64.
+// The value may be a string holding the number, like `"18446744073709551615"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) AddUint64String(v *uint64) error {
+	return dec.Uint64String(v)
+}
+
+// Uint64String decodes the JSON value within an object or an array to a *uint64.
+// The value may be a string holding the number, like `"18446744073709551615"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) Uint64String(v *uint64) error {
+	return dec.withQuotedNumbers(func() error {
+		return dec.decodeUint64(v)
+	})
+}
+
+// AddFloat32String decodes the JSON value within an object or an array to a *float32.
+// The value may be a string holding the number, like `"1.5"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) AddFloat32String(v *float32) error {
+	return dec.Float32String(v)
+}
+
+// Float32String decodes the JSON value within an object or an array to a *float32.
+// The value may be a string holding the number, like `"1.5"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) Float32String(v *float32) error {
+	return dec.withQuotedNumbers(func() error {
+		return dec.decodeFloat32(v)
+	})
+}
+
+// AddFloat64String decodes the JSON value within an object or an array to a *float64.
+// The value may be a string holding the number, like `"1.5"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) AddFloat64String(v *float64) error {
+	return dec.Float64String(v)
+}
+
+// Float64String decodes the JSON value within an object or an array to a *float64.
+// The value may be a string holding the number, like `"1.5"`, see Decoder.UseQuotedNumbers.
+func (dec *Decoder) Float64String(v *float64) error {
+	return dec.withQuotedNumbers(func() error {
+		return dec.decodeFloat64(v)
+	})
+}
@@ -0,0 +1,259 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoderQuotedNumbers(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		v        interface{}
+		expected interface{}
+		err      bool
+	}{
+		{name: "int64", json: `"9007199254740993"`, v: new(int64), expected: int64(9007199254740993)},
+		{name: "int64-negative", json: `"-9223372036854775808"`, v: new(int64), expected: int64(-9223372036854775808)},
+		{name: "int64-unquoted", json: `42`, v: new(int64), expected: int64(42)},
+		{name: "int64-spaces", json: ` "42" `, v: new(int64), expected: int64(42)},
+		{name: "int64-fraction", json: `"1.5"`, v: new(int64), expected: int64(0), err: true},
+		{name: "int64-exponent", json: `"1e3"`, v: new(int64), expected: int64(0), err: true},
+		{name: "int64-not-number", json: `"abc"`, v: new(int64), expected: int64(0), err: true},
+		{name: "int64-empty", json: `""`, v: new(int64), expected: int64(0), err: true},
+		{name: "int64-padded", json: `" 42"`, v: new(int64), expected: int64(0), err: true},
+		{name: "int64-overflow", json: `"9223372036854775808"`, v: new(int64), expected: int64(0), err: true},
+		{name: "int", json: `"-12"`, v: new(int), expected: -12},
+		{name: "int8", json: `"-128"`, v: new(int8), expected: int8(-128)},
+		{name: "int8-overflow", json: `"128"`, v: new(int8), expected: int8(0), err: true},
+		{name: "int16", json: `"32767"`, v: new(int16), expected: int16(32767)},
+		{name: "int32", json: `"-2147483648"`, v: new(int32), expected: int32(-2147483648)},
+		{name: "uint8", json: `"255"`, v: new(uint8), expected: uint8(255)},
+		{name: "uint16", json: `"65535"`, v: new(uint16), expected: uint16(65535)},
+		{name: "uint32", json: `"4294967295"`, v: new(uint32), expected: uint32(4294967295)},
+		{name: "uint64", json: `"18446744073709551615"`, v: new(uint64), expected: uint64(18446744073709551615)},
+		{name: "uint64-negative", json: `"-1"`, v: new(uint64), expected: uint64(0), err: true},
+		{name: "float64", json: `"1.5e3"`, v: new(float64), expected: 1500.0},
+		{name: "float64-not-number", json: `"NaN"`, v: new(float64), expected: 0.0, err: true},
+		{name: "float32", json: `"-0.25"`, v: new(float32), expected: float32(-0.25)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := UnmarshalWithOptions([]byte(testCase.json), testCase.v, QuotedNumbers())
+			if testCase.err {
+				require.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+			} else {
+				require.Nil(t, err, "err should be nil")
+			}
+			assert.Equal(t, testCase.expected, deref(testCase.v))
+		})
+	}
+}
+
+// deref returns the value pointed to by v, a pointer to a number.
+func deref(v interface{}) interface{} {
+	switch vt := v.(type) {
+	case *int:
+		return *vt
+	case *int8:
+		return *vt
+	case *int16:
+		return *vt
+	case *int32:
+		return *vt
+	case *int64:
+		return *vt
+	case *uint8:
+		return *vt
+	case *uint16:
+		return *vt
+	case *uint32:
+		return *vt
+	case *uint64:
+		return *vt
+	case *float32:
+		return *vt
+	case *float64:
+		return *vt
+	}
+	return nil
+}
+
+func TestDecoderQuotedNumbersDisabled(t *testing.T) {
+	var v int64
+	err := Unmarshal([]byte(`"42"`), &v)
+	require.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+	assert.Equal(t, int64(0), v)
+
+	dec := BorrowDecoder(nil)
+	dec.UseQuotedNumbers()
+	dec.Release()
+	dec = BorrowDecoder(strings.NewReader(`"42"`))
+	defer dec.Release()
+	err = dec.Decode(&v)
+	require.IsType(t, InvalidUnmarshalError(""), err, "the option should be reset")
+}
+
+func TestDecoderQuotedNumbersDisabledErrors(t *testing.T) {
+	v := &testQuotedObject{}
+	err := UnmarshalJSONObjectWithOptions([]byte(`{"other":"2","id":"1"}`), v, DetailedErrors())
+	require.IsType(t, &DecodeError{}, err, "err should be of type *DecodeError")
+	decErr := err.(*DecodeError)
+	assert.Equal(t, "$.other", decErr.Path)
+	assert.Equal(t, 9, decErr.Offset)
+	assert.Equal(t, `"2"`, decErr.Token)
+	assert.IsType(t, InvalidUnmarshalError(""), decErr.Err)
+	assert.Equal(t, int64(1), v.id, "decoding should go on after the error")
+
+	err = UnmarshalJSONObjectWithOptions([]byte(`{"other":"2","count":"x","id":"1"}`), &testQuotedObject{}, AllErrors())
+	require.IsType(t, DecodeErrors{}, err, "err should be of type DecodeErrors")
+	errs := err.(DecodeErrors)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "$.other", errs[0].Path)
+	assert.Equal(t, "$.count", errs[1].Path)
+}
+
+func TestDecoderQuotedNumbersNull(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expected *int64
+		err      bool
+	}{
+		{name: "quoted", json: `{"v":"9007199254740993"}`, expected: func() *int64 { v := int64(9007199254740993); return &v }()},
+		{name: "null", json: `{"v":null}`},
+		{name: "invalid", json: `{"v":"x"}`, err: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var v *int64
+			dec := NewDecoder(strings.NewReader(testCase.json))
+			dec.UseQuotedNumbers()
+			err := dec.Decode(DecodeObjectFunc(func(dec *Decoder, k string) error {
+				return dec.Int64Null(&v)
+			}))
+			if testCase.err {
+				require.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+				return
+			}
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, v)
+		})
+	}
+}
+
+type testQuotedObject struct {
+	id    int64
+	count uint64
+	price float64
+	other int64
+}
+
+func (t *testQuotedObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "id":
+		return dec.Int64String(&t.id)
+	case "count":
+		return dec.Uint64String(&t.count)
+	case "price":
+		return dec.Float64String(&t.price)
+	case "other":
+		return dec.Int64(&t.other)
+	}
+	return nil
+}
+
+func (t *testQuotedObject) NKeys() int {
+	return 4
+}
+
+func TestDecoderInt64String(t *testing.T) {
+	json := `{"id":"9007199254740993","count":"18446744073709551615","price":"1.25","other":1}`
+	v := &testQuotedObject{}
+	err := UnmarshalJSONObject([]byte(json), v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, &testQuotedObject{id: 9007199254740993, count: 18446744073709551615, price: 1.25, other: 1}, v)
+
+	v = &testQuotedObject{}
+	dec := BorrowDecoder(iotest.OneByteReader(strings.NewReader(json)))
+	defer dec.Release()
+	err = dec.DecodeObject(v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, &testQuotedObject{id: 9007199254740993, count: 18446744073709551615, price: 1.25, other: 1}, v)
+
+	err = UnmarshalJSONObject([]byte(`{"id":9007199254740993,"count":null}`), &testQuotedObject{})
+	assert.Nil(t, err, "unquoted numbers and null should be accepted")
+
+	err = UnmarshalJSONObject([]byte(`{"id":"1","other":"1"}`), &testQuotedObject{})
+	assert.IsType(t, InvalidUnmarshalError(""), err, "other numbers should not accept strings")
+}
+
+func TestDecoderStringHelpers(t *testing.T) {
+	var (
+		i   int
+		i8  int8
+		i16 int16
+		i32 int32
+		u8  uint8
+		u16 uint16
+		u32 uint32
+		f32 float32
+		b   bool
+	)
+	testCases := []struct {
+		name     string
+		json     string
+		decode   func(dec *Decoder) error
+		v        interface{}
+		expected interface{}
+		err      bool
+	}{
+		{name: "int", json: `"-12"`, decode: func(dec *Decoder) error { return dec.IntString(&i) }, v: &i, expected: -12},
+		{name: "int-empty", json: `""`, decode: func(dec *Decoder) error { return dec.AddIntString(&i) }, v: &i, expected: 0, err: true},
+		{name: "int8", json: `"-128"`, decode: func(dec *Decoder) error { return dec.Int8String(&i8) }, v: &i8, expected: int8(-128)},
+		{name: "int8-overflow", json: `"128"`, decode: func(dec *Decoder) error { return dec.AddInt8String(&i8) }, v: &i8, expected: int8(0), err: true},
+		{name: "int16", json: `"32767"`, decode: func(dec *Decoder) error { return dec.Int16String(&i16) }, v: &i16, expected: int16(32767)},
+		{name: "int16-overflow", json: `"32768"`, decode: func(dec *Decoder) error { return dec.AddInt16String(&i16) }, v: &i16, expected: int16(0), err: true},
+		{name: "int32", json: `2`, decode: func(dec *Decoder) error { return dec.Int32String(&i32) }, v: &i32, expected: int32(2)},
+		{name: "int32-overflow", json: `"2147483648"`, decode: func(dec *Decoder) error { return dec.AddInt32String(&i32) }, v: &i32, expected: int32(0), err: true},
+		{name: "uint8", json: `"255"`, decode: func(dec *Decoder) error { return dec.Uint8String(&u8) }, v: &u8, expected: uint8(255)},
+		{name: "uint8-overflow", json: `"256"`, decode: func(dec *Decoder) error { return dec.AddUint8String(&u8) }, v: &u8, expected: uint8(0), err: true},
+		{name: "uint16", json: `"65535"`, decode: func(dec *Decoder) error { return dec.Uint16String(&u16) }, v: &u16, expected: uint16(65535)},
+		{name: "uint16-negative", json: `"-1"`, decode: func(dec *Decoder) error { return dec.AddUint16String(&u16) }, v: &u16, expected: uint16(0), err: true},
+		{name: "uint32", json: `"4294967295"`, decode: func(dec *Decoder) error { return dec.Uint32String(&u32) }, v: &u32, expected: uint32(4294967295)},
+		{name: "uint32-empty", json: `""`, decode: func(dec *Decoder) error { return dec.AddUint32String(&u32) }, v: &u32, expected: uint32(0), err: true},
+		{name: "float32", json: `"-0.25"`, decode: func(dec *Decoder) error { return dec.Float32String(&f32) }, v: &f32, expected: float32(-0.25)},
+		{name: "float32-empty", json: `""`, decode: func(dec *Decoder) error { return dec.AddFloat32String(&f32) }, v: &f32, expected: float32(0), err: true},
+		{name: "bool-true", json: `"true"`, decode: func(dec *Decoder) error { return dec.BoolString(&b) }, v: &b, expected: true},
+		{name: "bool-false", json: `"false"`, decode: func(dec *Decoder) error { return dec.AddBoolString(&b) }, v: &b, expected: false},
+		{name: "bool-unquoted", json: `true`, decode: func(dec *Decoder) error { return dec.BoolString(&b) }, v: &b, expected: true},
+		{name: "bool-null", json: `null`, decode: func(dec *Decoder) error { return dec.BoolString(&b) }, v: &b, expected: false},
+		{name: "bool-empty", json: `""`, decode: func(dec *Decoder) error { return dec.BoolString(&b) }, v: &b, expected: false, err: true},
+		{name: "bool-not-bool", json: `"1"`, decode: func(dec *Decoder) error { return dec.BoolString(&b) }, v: &b, expected: false, err: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			i, i8, i16, i32, u8, u16, u32, f32, b = 0, 0, 0, 0, 0, 0, 0, 0, false
+			err := UnmarshalJSONObject([]byte(`{"v":`+testCase.json+`,"w":1}`), DecodeObjectFunc(func(dec *Decoder, k string) error {
+				if k == "v" {
+					return testCase.decode(dec)
+				}
+				return nil
+			}))
+			if testCase.err {
+				require.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+			} else {
+				require.Nil(t, err, "err should be nil")
+			}
+			if v, ok := testCase.v.(*bool); ok {
+				assert.Equal(t, testCase.expected, *v)
+				return
+			}
+			assert.Equal(t, testCase.expected, deref(testCase.v))
+		})
+	}
+}
@@ -11,10 +11,16 @@ func (dec *Decoder) DecodeUint8(v *uint8) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeUint8(v)
 }
 
 func (dec *Decoder) decodeUint8(v *uint8) error {
+	if dec.intPolicy != nil {
+		return decodeUintWithPolicy(dec, v, math.MaxUint8)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -27,8 +33,7 @@ func (dec *Decoder) decodeUint8(v *uint8) error {
 			*v = val
 			return nil
 		case '-': // if negative, we just set it to 0 and set error
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -40,9 +45,15 @@ func (dec *Decoder) decodeUint8(v *uint8) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedUint(v, 8)
+			if err != nil || !ok {
+				return err
+			}
+			*v = uint8(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -52,6 +63,9 @@ func (dec *Decoder) decodeUint8(v *uint8) error {
 	return dec.raiseInvalidJSONErr(dec.cursor)
 }
 func (dec *Decoder) decodeUint8Null(v **uint8) error {
+	if dec.intPolicy != nil {
+		return decodeUintNullWithPolicy(dec, v, math.MaxUint8)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -67,8 +81,7 @@ func (dec *Decoder) decodeUint8Null(v **uint8) error {
 			**v = val
 			return nil
 		case '-': // if negative, we just set it to 0 and set error
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -83,9 +96,18 @@ func (dec *Decoder) decodeUint8Null(v **uint8) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedUint(v, 8)
+			if err != nil || !ok {
+				return err
+			}
+			if *v == nil {
+				*v = new(uint8)
+			}
+			**v = uint8(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -123,10 +145,16 @@ func (dec *Decoder) DecodeUint16(v *uint16) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeUint16(v)
 }
 
 func (dec *Decoder) decodeUint16(v *uint16) error {
+	if dec.intPolicy != nil {
+		return decodeUintWithPolicy(dec, v, math.MaxUint16)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -139,8 +167,7 @@ func (dec *Decoder) decodeUint16(v *uint16) error {
 			*v = val
 			return nil
 		case '-':
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -152,9 +179,15 @@ func (dec *Decoder) decodeUint16(v *uint16) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedUint(v, 16)
+			if err != nil || !ok {
+				return err
+			}
+			*v = uint16(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -164,6 +197,9 @@ func (dec *Decoder) decodeUint16(v *uint16) error {
 	return dec.raiseInvalidJSONErr(dec.cursor)
 }
 func (dec *Decoder) decodeUint16Null(v **uint16) error {
+	if dec.intPolicy != nil {
+		return decodeUintNullWithPolicy(dec, v, math.MaxUint16)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -179,8 +215,7 @@ func (dec *Decoder) decodeUint16Null(v **uint16) error {
 			**v = val
 			return nil
 		case '-':
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -195,9 +230,18 @@ func (dec *Decoder) decodeUint16Null(v **uint16) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedUint(v, 16)
+			if err != nil || !ok {
+				return err
+			}
+			if *v == nil {
+				*v = new(uint16)
+			}
+			**v = uint16(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -235,10 +279,16 @@ func (dec *Decoder) DecodeUint32(v *uint32) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeUint32(v)
 }
 
 func (dec *Decoder) decodeUint32(v *uint32) error {
+	if dec.intPolicy != nil {
+		return decodeUintWithPolicy(dec, v, math.MaxUint32)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -251,8 +301,7 @@ func (dec *Decoder) decodeUint32(v *uint32) error {
 			*v = val
 			return nil
 		case '-':
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -264,9 +313,15 @@ func (dec *Decoder) decodeUint32(v *uint32) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedUint(v, 32)
+			if err != nil || !ok {
+				return err
+			}
+			*v = uint32(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -276,6 +331,9 @@ func (dec *Decoder) decodeUint32(v *uint32) error {
 	return dec.raiseInvalidJSONErr(dec.cursor)
 }
 func (dec *Decoder) decodeUint32Null(v **uint32) error {
+	if dec.intPolicy != nil {
+		return decodeUintNullWithPolicy(dec, v, math.MaxUint32)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -291,8 +349,7 @@ func (dec *Decoder) decodeUint32Null(v **uint32) error {
 			**v = val
 			return nil
 		case '-':
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -307,9 +364,18 @@ func (dec *Decoder) decodeUint32Null(v **uint32) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedUint(v, 32)
+			if err != nil || !ok {
+				return err
+			}
+			if *v == nil {
+				*v = new(uint32)
+			}
+			**v = uint32(val)
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -347,9 +413,15 @@ func (dec *Decoder) DecodeUint64(v *uint64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeUint64(v)
 }
 func (dec *Decoder) decodeUint64(v *uint64) error {
+	if dec.intPolicy != nil {
+		return decodeUintWithPolicy(dec, v, math.MaxUint64)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -362,8 +434,7 @@ func (dec *Decoder) decodeUint64(v *uint64) error {
 			*v = val
 			return nil
 		case '-':
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -375,9 +446,15 @@ func (dec *Decoder) decodeUint64(v *uint64) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedUint(v, 64)
+			if err != nil || !ok {
+				return err
+			}
+			*v = val
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -387,6 +464,9 @@ func (dec *Decoder) decodeUint64(v *uint64) error {
 	return dec.raiseInvalidJSONErr(dec.cursor)
 }
 func (dec *Decoder) decodeUint64Null(v **uint64) error {
+	if dec.intPolicy != nil {
+		return decodeUintNullWithPolicy(dec, v, math.MaxUint64)
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch c := dec.data[dec.cursor]; c {
 		case ' ', '\n', '\t', '\r', ',':
@@ -402,8 +482,7 @@ func (dec *Decoder) decodeUint64Null(v **uint64) error {
 			**v = val
 			return nil
 		case '-':
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -418,9 +497,18 @@ func (dec *Decoder) decodeUint64Null(v **uint64) error {
 				return err
 			}
 			return nil
+		case '"':
+			val, ok, err := dec.getQuotedUint(v, 64)
+			if err != nil || !ok {
+				return err
+			}
+			if *v == nil {
+				*v = new(uint64)
+			}
+			**v = val
+			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -14,16 +14,73 @@ func (dec *Decoder) DecodeObject(j UnmarshalerJSONObject) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	_, err := dec.decodeObject(j)
 	return err
 }
+
+// DisallowUnknownFields returns a DecoderOption disallowing unknown fields.
+// See Decoder.DisallowUnknownFields.
+func DisallowUnknownFields() DecoderOption {
+	return func(dec *Decoder) {
+		dec.DisallowUnknownFields()
+	}
+}
+
+// DisallowUnknownFields causes the Decoder to return an *UnknownFieldError
+// when an object has a key which is not decoded by its UnmarshalJSONObject method,
+// as in the decoders generated by the gojay command.
+// The error is returned once the object has been decoded, as for type mismatches.
+//
+// Keys given to an UnknownKeysCollector are not unknown fields.
+//
+// As all keys must be read, the NKeys optimization is disabled.
+func (dec *Decoder) DisallowUnknownFields() {
+	dec.disallowUnknown = true
+}
+
 func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
+	depth := dec.pushPath()
+	lastDepth := dec.depth
+	end, err := dec.decodeObjectAt(j, depth)
+	dec.depth = lastDepth
+	dec.popPath(depth)
+	return end, err
+}
+
+// decodeObjectAt decodes the object at the cursor, depth being its segment in the path, see Decoder.pushPath.
+func (dec *Decoder) decodeObjectAt(j UnmarshalerJSONObject, depth int) (int, error) {
 	keys := j.NKeys()
+	// keys must all be read to find unknown ones
+	if dec.disallowUnknown {
+		keys = 0
+	}
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
 		case ' ', '\n', '\t', '\r', ',':
 		case '{':
+			var nKeys int
+			var req requiredKeys
+			if r, ok := j.(UnmarshalerRequiredKeys); ok {
+				req.keys = r.RequiredKeys()
+				req.fold = dec.caseInsensitiveKeys
+				if a, ok := j.(UnmarshalerKeyAliases); ok {
+					req.aliases = a.KeyAliases()
+				}
+				// keys must all be read to find required ones
+				keys = 0
+			}
+			collector, _ := j.(UnknownKeysCollector)
+			if collector != nil {
+				// keys must all be read to collect unknown ones
+				keys = 0
+			}
 			dec.cursor = dec.cursor + 1
+			if err := dec.incDepth(); err != nil {
+				return 0, err
+			}
 			// if keys is zero we will parse all keys
 			// we run two loops for micro optimization
 			if keys == 0 {
@@ -32,14 +89,24 @@ func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
 					if err != nil {
 						return 0, err
 					} else if done {
+						if req.keys != nil {
+							dec.checkRequiredKeys(&req, depth)
+						}
 						return dec.cursor, nil
 					}
-					err = j.UnmarshalJSONObject(dec, k)
+					if nKeys++; exceedsLimit(dec.maxObjectKeys, nKeys) {
+						return 0, dec.raiseLimitErr(LimitObjectKeys, dec.maxObjectKeys, dec.cursor)
+					}
+					dec.setPathKey(depth, k)
+					if req.keys != nil {
+						req.found(k)
+					}
+					err = dec.unmarshalKey(j, k)
 					if err != nil {
 						dec.err = err
 						return 0, err
 					} else if dec.called&1 == 0 {
-						err := dec.skipData()
+						err := dec.skipUnknownKey(collector, k, depth)
 						if err != nil {
 							return 0, err
 						}
@@ -54,14 +121,24 @@ func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
 					if err != nil {
 						return 0, err
 					} else if done {
+						if req.keys != nil {
+							dec.checkRequiredKeys(&req, depth)
+						}
 						return dec.cursor, nil
 					}
-					err = j.UnmarshalJSONObject(dec, k)
+					if nKeys++; exceedsLimit(dec.maxObjectKeys, nKeys) {
+						return 0, dec.raiseLimitErr(LimitObjectKeys, dec.maxObjectKeys, dec.cursor)
+					}
+					dec.setPathKey(depth, k)
+					if req.keys != nil {
+						req.found(k)
+					}
+					err = dec.unmarshalKey(j, k)
 					if err != nil {
 						dec.err = err
 						return 0, err
 					} else if dec.called&1 == 0 {
-						err := dec.skipData()
+						err := dec.skipUnknownKey(collector, k, depth)
 						if err != nil {
 							return 0, err
 						}
@@ -74,8 +151,13 @@ func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
 			// will get to that point when keysDone is not lower than keys anymore
 			// in that case, we make sure cursor goes to the end of object, but we skip
 			// unmarshalling
+			if req.keys != nil {
+				dec.checkRequiredKeys(&req, depth)
+			}
 			if dec.child&1 != 0 {
-				end, err := dec.skipObject()
+				// the object is counted again by skipObject
+				dec.depth--
+				end, err := dec.skipObjectRest(nKeys)
 				dec.cursor = end
 				return dec.cursor, err
 			}
@@ -89,8 +171,7 @@ func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
 			return dec.cursor, nil
 		default:
 			// can't unmarshal to struct
-			dec.err = dec.makeInvalidUnmarshalErr(j)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(j, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return 0, err
 			}
@@ -101,6 +182,17 @@ func (dec *Decoder) decodeObject(j UnmarshalerJSONObject) (int, error) {
 }
 
 func (dec *Decoder) decodeObjectNull(v interface{}) (int, error) {
+	depth := dec.pushPath()
+	lastDepth := dec.depth
+	end, err := dec.decodeObjectNullAt(v, depth)
+	dec.depth = lastDepth
+	dec.popPath(depth)
+	return end, err
+}
+
+// decodeObjectNullAt decodes the object at the cursor to v, a pointer to a pointer,
+// depth being its segment in the path, see Decoder.pushPath.
+func (dec *Decoder) decodeObjectNullAt(v interface{}, depth int) (int, error) {
 	// make sure the value is a pointer
 	vv := reflect.ValueOf(v)
 	vvt := vv.Type()
@@ -122,7 +214,30 @@ func (dec *Decoder) decodeObjectNull(v interface{}) (int, error) {
 				return 0, dec.err
 			}
 			keys := j.NKeys()
+			// keys must all be read to find unknown ones
+			if dec.disallowUnknown {
+				keys = 0
+			}
+			var nKeys int
+			var req requiredKeys
+			if r, ok := j.(UnmarshalerRequiredKeys); ok {
+				req.keys = r.RequiredKeys()
+				req.fold = dec.caseInsensitiveKeys
+				if a, ok := j.(UnmarshalerKeyAliases); ok {
+					req.aliases = a.KeyAliases()
+				}
+				// keys must all be read to find required ones
+				keys = 0
+			}
+			collector, _ := j.(UnknownKeysCollector)
+			if collector != nil {
+				// keys must all be read to collect unknown ones
+				keys = 0
+			}
 			dec.cursor = dec.cursor + 1
+			if err := dec.incDepth(); err != nil {
+				return 0, err
+			}
 			// if keys is zero we will parse all keys
 			// we run two loops for micro optimization
 			if keys == 0 {
@@ -131,14 +246,24 @@ func (dec *Decoder) decodeObjectNull(v interface{}) (int, error) {
 					if err != nil {
 						return 0, err
 					} else if done {
+						if req.keys != nil {
+							dec.checkRequiredKeys(&req, depth)
+						}
 						return dec.cursor, nil
 					}
-					err = j.UnmarshalJSONObject(dec, k)
+					if nKeys++; exceedsLimit(dec.maxObjectKeys, nKeys) {
+						return 0, dec.raiseLimitErr(LimitObjectKeys, dec.maxObjectKeys, dec.cursor)
+					}
+					dec.setPathKey(depth, k)
+					if req.keys != nil {
+						req.found(k)
+					}
+					err = dec.unmarshalKey(j, k)
 					if err != nil {
 						dec.err = err
 						return 0, err
 					} else if dec.called&1 == 0 {
-						err := dec.skipData()
+						err := dec.skipUnknownKey(collector, k, depth)
 						if err != nil {
 							return 0, err
 						}
@@ -153,14 +278,24 @@ func (dec *Decoder) decodeObjectNull(v interface{}) (int, error) {
 					if err != nil {
 						return 0, err
 					} else if done {
+						if req.keys != nil {
+							dec.checkRequiredKeys(&req, depth)
+						}
 						return dec.cursor, nil
 					}
-					err = j.UnmarshalJSONObject(dec, k)
+					if nKeys++; exceedsLimit(dec.maxObjectKeys, nKeys) {
+						return 0, dec.raiseLimitErr(LimitObjectKeys, dec.maxObjectKeys, dec.cursor)
+					}
+					dec.setPathKey(depth, k)
+					if req.keys != nil {
+						req.found(k)
+					}
+					err = dec.unmarshalKey(j, k)
 					if err != nil {
 						dec.err = err
 						return 0, err
 					} else if dec.called&1 == 0 {
-						err := dec.skipData()
+						err := dec.skipUnknownKey(collector, k, depth)
 						if err != nil {
 							return 0, err
 						}
@@ -173,8 +308,13 @@ func (dec *Decoder) decodeObjectNull(v interface{}) (int, error) {
 			// will get to that point when keysDone is not lower than keys anymore
 			// in that case, we make sure cursor goes to the end of object, but we skip
 			// unmarshalling
+			if req.keys != nil {
+				dec.checkRequiredKeys(&req, depth)
+			}
 			if dec.child&1 != 0 {
-				end, err := dec.skipObject()
+				// the object is counted again by skipObject
+				dec.depth--
+				end, err := dec.skipObjectRest(nKeys)
 				dec.cursor = end
 				return dec.cursor, err
 			}
@@ -188,8 +328,7 @@ func (dec *Decoder) decodeObjectNull(v interface{}) (int, error) {
 			return dec.cursor, nil
 		default:
 			// can't unmarshal to struct
-			dec.err = dec.makeInvalidUnmarshalErr((UnmarshalerJSONObject)(nil))
-			err := dec.skipData()
+			err := dec.skipInvalidValue((UnmarshalerJSONObject)(nil), dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return 0, err
 			}
@@ -199,20 +338,111 @@ func (dec *Decoder) decodeObjectNull(v interface{}) (int, error) {
 	return 0, dec.raiseInvalidJSONErr(dec.cursor)
 }
 
+// requiredKeys tracks the required keys found while decoding an object.
+type requiredKeys struct {
+	keys []string
+	// aliases maps alias keys to the required key they stand for
+	aliases map[string]string
+	// fold is set if keys are matched ignoring ASCII case
+	fold bool
+	// bit i is set when keys[i] is found, more is used past 64 keys
+	mask uint64
+	more []bool
+}
+
+func (req *requiredKeys) found(k string) {
+	if req.aliases != nil {
+		k = req.resolveAlias(k)
+	}
+	for i, key := range req.keys {
+		if key != k && !(req.fold && equalFoldASCII(key, k)) {
+			continue
+		}
+		if i < 64 {
+			req.mask |= 1 << uint(i)
+		} else {
+			if req.more == nil {
+				req.more = make([]bool, len(req.keys)-64)
+			}
+			req.more[i-64] = true
+		}
+		return
+	}
+}
+
+// resolveAlias returns the required key k stands for, or k if it is not an alias.
+func (req *requiredKeys) resolveAlias(k string) string {
+	if key, ok := req.aliases[k]; ok {
+		return key
+	}
+	if req.fold {
+		for alias, key := range req.aliases {
+			if equalFoldASCII(alias, k) {
+				return key
+			}
+		}
+	}
+	return k
+}
+
+func (req *requiredKeys) isFound(i int) bool {
+	if i < 64 {
+		return req.mask&(1<<uint(i)) != 0
+	}
+	return req.more != nil && req.more[i-64]
+}
+
+// checkRequiredKeys sets a MissingKeysError if required keys were not found in the object at depth.
+func (dec *Decoder) checkRequiredKeys(req *requiredKeys, depth int) {
+	var missing []string
+	for i, k := range req.keys {
+		if !req.isFound(i) {
+			missing = append(missing, k)
+		}
+	}
+	if missing != nil {
+		dec.err = dec.decorateErr(
+			&MissingKeysError{
+				Keys: missing,
+				Path: dec.pathString(depth),
+			},
+			dec.cursor-1,
+			"",
+		)
+	}
+}
+
 func (dec *Decoder) skipObject() (int, error) {
+	if dec.hasElementLimits() {
+		return dec.skipObjectLimited(0)
+	}
 	var objectsOpen = 1
 	var objectsClosed = 0
+	// nested counts the objects and arrays open, to enforce the maximum depth
+	var nested = 1
+	if dec.exceedsDepth(nested) {
+		return 0, dec.raiseMaxDepthErr(dec.cursor - 1)
+	}
 	for j := dec.cursor; j < dec.length || dec.read(); j++ {
 		switch dec.data[j] {
 		case '}':
 			objectsClosed++
+			nested--
 			// everything is closed return
 			if objectsOpen == objectsClosed {
 				// add char to object data
 				return j + 1, nil
 			}
-		case '{':
-			objectsOpen++
+		case '{', '[':
+			if dec.data[j] == '{' {
+				objectsOpen++
+			}
+			nested++
+			if dec.exceedsDepth(nested) {
+				return 0, dec.raiseMaxDepthErr(j)
+			}
+		case ']':
+			nested--
 		case '"':
 			j++
 			var isInEscapeSeq bool
@@ -251,6 +481,14 @@ func (dec *Decoder) skipObject() (int, error) {
 	return 0, dec.raiseInvalidJSONErr(dec.cursor)
 }
 
+// skipObjectRest skips the keys left in an object of which nKeys keys were read.
+func (dec *Decoder) skipObjectRest(nKeys int) (int, error) {
+	if dec.hasElementLimits() {
+		return dec.skipObjectLimited(nKeys)
+	}
+	return dec.skipObject()
+}
+
 func (dec *Decoder) nextKey() (string, bool, error) {
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
@@ -286,6 +524,26 @@ func (dec *Decoder) nextKey() (string, bool, error) {
 	return "", false, dec.raiseInvalidJSONErr(dec.cursor)
 }
 
+// skipUnknownKey skips the value of the key k which was not decoded,
+// giving it to the collector if there is one.
+func (dec *Decoder) skipUnknownKey(collector UnknownKeysCollector, k string, depth int) error {
+	if collector == nil {
+		if dec.disallowUnknown {
+			dec.err = dec.makeUnknownFieldErr(k, depth)
+		}
+		return dec.skipData()
+	}
+	// the key points to the buffer, which may be reallocated while reading the value
+	key := string([]byte(k))
+	dec.nextChar()
+	start := dec.cursor
+	if err := dec.skipData(); err != nil {
+		return err
+	}
+	collector.CollectUnknownKey(key, append(EmbeddedJSON(nil), dec.data[start:dec.cursor]...))
+	return nil
+}
+
 func (dec *Decoder) skipData() error {
 	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
 		switch dec.data[dec.cursor] {
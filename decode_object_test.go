@@ -1,6 +1,7 @@
 package gojay
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"reflect"
@@ -1900,3 +1901,217 @@ func TestSkipData(t *testing.T) {
 		assert.IsType(t, InvalidJSONError(""), err, "err should of type InvalidJSONError")
 	})
 }
+
+func TestDecodeObjectDisallowUnknownFields(t *testing.T) {
+	testCases := []struct {
+		name         string
+		json         string
+		expectedKey  string
+		expectedPath string
+	}{
+		{
+			name: "no-unknown",
+			json: `{"id":1,"items":[{"name":"a","price":1}]}`,
+		},
+		{
+			name:         "root",
+			json:         `{"idd":2,"items":[{"name":"a","price":1}]}`,
+			expectedKey:  "idd",
+			expectedPath: "$",
+		},
+		{
+			name:         "after-nkeys",
+			json:         `{"items":[{"name":"a","price":1},{"name":"b","price":2,"prise":3}]}`,
+			expectedKey:  "prise",
+			expectedPath: "$.items[1]",
+		},
+		{
+			name:         "nested",
+			json:         `{"items":[{"name":"a","extra":{"x":[1,2]},"price":1}]}`,
+			expectedKey:  "extra",
+			expectedPath: "$.items[0]",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := &testPathOrder{}
+			err := UnmarshalJSONObjectWithOptions([]byte(testCase.json), v, DisallowUnknownFields())
+			if testCase.expectedKey == "" {
+				assert.Nil(t, err, "err should be nil")
+				return
+			}
+			assert.IsType(t, &UnknownFieldError{}, err, "err should be an *UnknownFieldError")
+			unknownErr := err.(*UnknownFieldError)
+			assert.Equal(t, testCase.expectedKey, unknownErr.Key, "unknownErr.Key should be equal to expected key")
+			assert.Equal(t, testCase.expectedPath, unknownErr.Path, "unknownErr.Path should be equal to expected path")
+			// the object is still decoded
+			assert.NotNil(t, v.items, "v.items should be decoded")
+		})
+	}
+	t.Run("allowed-by-default", func(t *testing.T) {
+		v := &testPathOrder{}
+		err := UnmarshalJSONObject([]byte(`{"id":1,"idd":2}`), v)
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, 1, v.id, "v.id should be 1")
+	})
+	t.Run("detailed", func(t *testing.T) {
+		dec := NewDecoder(strings.NewReader(`{"id":1,"items":[{"name":"a","typo":1}]}`))
+		dec.DisallowUnknownFields()
+		dec.UseDetailedErrors()
+		v := &testPathOrder{}
+		err := dec.Decode(v)
+		var unknownErr *UnknownFieldError
+		assert.True(t, errors.As(err, &unknownErr), "err should wrap an *UnknownFieldError")
+		assert.Equal(t, "typo", unknownErr.Key)
+		assert.Equal(t, "$.items[0]", unknownErr.Path)
+		assert.Equal(t, `Unknown field "typo" in object at $.items[0]`, unknownErr.Error())
+	})
+}
+
+type testRequiredKeys struct {
+	id    int
+	name  string
+	price int
+}
+
+func (t *testRequiredKeys) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "id":
+		return dec.Int(&t.id)
+	case "name":
+		return dec.String(&t.name)
+	case "price":
+		return dec.Int(&t.price)
+	}
+	return nil
+}
+
+func (t *testRequiredKeys) NKeys() int {
+	return 3
+}
+
+func (t *testRequiredKeys) RequiredKeys() []string {
+	return []string{"id", "name"}
+}
+
+type testRequiredKeysSlice []*testRequiredKeys
+
+func (t *testRequiredKeysSlice) UnmarshalJSONArray(dec *Decoder) error {
+	v := &testRequiredKeys{}
+	*t = append(*t, v)
+	return dec.ObjectNull(&v)
+}
+
+func TestDecodeObjectRequiredKeys(t *testing.T) {
+	testCases := []struct {
+		name         string
+		json         string
+		expectedKeys []string
+		expectedPath string
+	}{
+		{
+			name: "all-present",
+			json: `[{"id":1,"name":"a"},{"price":1,"name":"b","id":2}]`,
+		},
+		{
+			name: "null-present",
+			json: `[{"id":null,"name":null}]`,
+		},
+		{
+			name:         "one-missing",
+			json:         `[{"id":1,"name":"a"},{"price":1,"id":2}]`,
+			expectedKeys: []string{"name"},
+			expectedPath: "$[1]",
+		},
+		{
+			name:         "all-missing",
+			json:         `[{}]`,
+			expectedKeys: []string{"id", "name"},
+			expectedPath: "$[0]",
+		},
+		{
+			name:         "duplicate-keys",
+			json:         `[{"id":1,"id":2,"price":1,"name":"a"},{"id":1,"id":2,"price":1}]`,
+			expectedKeys: []string{"name"},
+			expectedPath: "$[1]",
+		},
+		{
+			name:         "nested-missing",
+			json:         `[{"id":1,"name":"a","extra":{"name":"b"}},{"id":1}]`,
+			expectedKeys: []string{"name"},
+			expectedPath: "$[1]",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := testRequiredKeysSlice{}
+			err := UnmarshalJSONArray([]byte(testCase.json), &v)
+			if testCase.expectedKeys == nil {
+				assert.Nil(t, err, "err should be nil")
+				return
+			}
+			assert.IsType(t, &MissingKeysError{}, err, "err should be a *MissingKeysError")
+			missingErr := err.(*MissingKeysError)
+			assert.Equal(t, testCase.expectedKeys, missingErr.Keys, "missingErr.Keys should be equal to expected keys")
+			assert.Equal(t, "", missingErr.Path, "the path should not be tracked without detailed errors")
+
+			v = testRequiredKeysSlice{}
+			err = UnmarshalJSONArrayWithOptions([]byte(testCase.json), &v, DetailedErrors())
+			assert.True(t, errors.As(err, &missingErr), "err should wrap a *MissingKeysError")
+			assert.Equal(t, testCase.expectedKeys, missingErr.Keys, "missingErr.Keys should be equal to expected keys")
+			assert.Equal(t, testCase.expectedPath, missingErr.Path, "missingErr.Path should be equal to expected path")
+		})
+	}
+	t.Run("error-message", func(t *testing.T) {
+		v := &testRequiredKeys{}
+		err := UnmarshalJSONObject([]byte(`{"price":1}`), v)
+		assert.Equal(t, `Missing required keys "id", "name"`, err.Error())
+		assert.Equal(t, 1, v.price, "v.price should be decoded")
+		err = &MissingKeysError{Keys: []string{"id"}, Path: "$"}
+		assert.Equal(t, `Missing required keys "id" in object at $`, err.Error())
+	})
+	t.Run("detailed", func(t *testing.T) {
+		v := &testRequiredKeys{}
+		err := UnmarshalJSONObjectWithOptions([]byte(`{"id":1}`), v, DetailedErrors())
+		var missingErr *MissingKeysError
+		assert.True(t, errors.As(err, &missingErr), "err should wrap a *MissingKeysError")
+		assert.Equal(t, []string{"name"}, missingErr.Keys)
+		assert.Equal(t, 7, err.(*DecodeError).Offset, "error should be located at the end of the object")
+	})
+}
+
+func TestDecodeObjectRequiredKeysMoreThan64(t *testing.T) {
+	keys := make([]string, 70)
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := range keys {
+		keys[i] = fmt.Sprintf("k%d", i)
+		if i != 1 && i != 65 {
+			if b.Len() > 1 {
+				b.WriteByte(',')
+			}
+			b.WriteString(fmt.Sprintf(`"k%d":%d`, i, i))
+		}
+	}
+	b.WriteByte('}')
+	v := testRequiredKeysFunc{keys: keys}
+	err := UnmarshalJSONObject([]byte(b.String()), v)
+	assert.IsType(t, &MissingKeysError{}, err, "err should be a *MissingKeysError")
+	assert.Equal(t, []string{"k1", "k65"}, err.(*MissingKeysError).Keys)
+}
+
+type testRequiredKeysFunc struct {
+	keys []string
+}
+
+func (t testRequiredKeysFunc) UnmarshalJSONObject(dec *Decoder, k string) error {
+	return nil
+}
+
+func (t testRequiredKeysFunc) NKeys() int {
+	return 0
+}
+
+func (t testRequiredKeysFunc) RequiredKeys() []string {
+	return t.keys
+}
@@ -0,0 +1,223 @@
+package gojay
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+)
+
+// maxTokenLength is the maximum length of the token reported in a DecodeError.
+const maxTokenLength = 64
+
+const (
+	pathNone byte = iota
+	pathKey
+	pathIndex
+)
+
+// pathSegment is the position of the value being decoded within an object or an array.
+type pathSegment struct {
+	key   string
+	index int
+	kind  byte
+}
+
+// DetailedErrors returns a DecoderOption enabling detailed errors.
+// See Decoder.UseDetailedErrors.
+func DetailedErrors() DecoderOption {
+	return func(dec *Decoder) {
+		dec.UseDetailedErrors()
+	}
+}
+
+// UseDetailedErrors causes the Decoder to return a *DecodeError instead of an InvalidJSONError or an InvalidUnmarshalError.
+// A DecodeError gives the position of the error in the input and the JSON path of the failing value.
+func (dec *Decoder) UseDetailedErrors() {
+	dec.detailed = true
+}
+
+// AllErrors returns a DecoderOption enabling the collection of all errors.
+// See Decoder.UseAllErrors.
+func AllErrors() DecoderOption {
+	return func(dec *Decoder) {
+		dec.UseAllErrors()
+	}
+}
+
+// UseAllErrors causes the Decoder to collect every error instead of returning only the last one.
+//
+// When a value cannot be decoded to the receiver type, the Decoder skips it and keeps decoding,
+// with this option each of these errors is recorded with its path and position.
+// Decoding stops at the first syntax error, which is recorded as well.
+// The errors are returned as DecodeErrors and can be retrieved with Decoder.Errors.
+func (dec *Decoder) UseAllErrors() {
+	dec.allErrors = true
+}
+
+// Errors returns the errors collected by the Decoder, see Decoder.UseAllErrors.
+func (dec *Decoder) Errors() DecodeErrors {
+	return dec.errs
+}
+
+// Path returns the JSON path of the value being decoded, for example $.items[3].price.
+//
+// The path is tracked only when it is reported: with detailed errors (see Decoder.UseDetailedErrors),
+// collected errors (see Decoder.UseAllErrors) or unknown fields disallowed (see Decoder.DisallowUnknownFields).
+// Otherwise Path returns an empty string.
+func (dec *Decoder) Path() string {
+	if !dec.tracksPath() {
+		return ""
+	}
+	return dec.pathString(len(dec.path))
+}
+
+// pathString returns the JSON path made of the n first segments,
+// or an empty string if n is negative as the path is not tracked.
+func (dec *Decoder) pathString(n int) string {
+	if n < 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteByte('$')
+	last := len(dec.path) - 1
+	for i, seg := range dec.path[:n] {
+		switch seg.kind {
+		case pathKey:
+			if isIdentifier(seg.key) {
+				b.WriteByte('.')
+				b.WriteString(seg.key)
+			} else {
+				b.WriteByte('[')
+				b.WriteString(strconv.Quote(seg.key))
+				b.WriteByte(']')
+			}
+		case pathIndex:
+			index := seg.index
+			// the index of the innermost array is still being incremented
+			if i == last {
+				index = dec.arrayIndex
+			}
+			b.WriteByte('[')
+			b.WriteString(strconv.Itoa(index))
+			b.WriteByte(']')
+		}
+	}
+	return b.String()
+}
+
+// tracksPath reports if the path of the values is tracked, as it is only reported
+// by detailed errors, collected errors and unknown fields.
+func (dec *Decoder) tracksPath() bool {
+	return dec.detailed || dec.allErrors || dec.disallowUnknown
+}
+
+// pushPath adds a segment for the value starting at the cursor and returns its depth,
+// or -1 if the path is not tracked.
+// It must be called before the array index is reset for a nested array.
+func (dec *Decoder) pushPath() int {
+	if !dec.tracksPath() {
+		return -1
+	}
+	n := len(dec.path)
+	if n > 0 && dec.path[n-1].kind == pathIndex {
+		dec.path[n-1].index = dec.arrayIndex
+	}
+	dec.path = append(dec.path, pathSegment{})
+	return n
+}
+
+func (dec *Decoder) popPath(depth int) {
+	if depth < 0 {
+		return
+	}
+	// clear the segment so that the key does not retain the buffer
+	dec.path[depth] = pathSegment{}
+	dec.path = dec.path[:depth]
+}
+
+// setPathKey sets the segment at depth to the key k, if the path is tracked.
+func (dec *Decoder) setPathKey(depth int, k string) {
+	if depth >= 0 {
+		dec.path[depth] = pathSegment{key: k, kind: pathKey}
+	}
+}
+
+// setPathIndex sets the segment at depth to an array index, if the path is tracked.
+func (dec *Decoder) setPathIndex(depth int) {
+	if depth >= 0 {
+		dec.path[depth].kind = pathIndex
+	}
+}
+
+// discard records the data removed from the start of the buffer,
+// so that positions in errors are relative to the whole input.
+func (dec *Decoder) discard(n int) {
+	d := dec.data[:n]
+	dec.offset += n
+	if i := bytes.LastIndexByte(d, '\n'); i >= 0 {
+		dec.line += bytes.Count(d, []byte{'\n'})
+		dec.column = n - i - 1
+	} else {
+		dec.column += n
+	}
+}
+
+// position returns the 1-based line and column of pos.
+func (dec *Decoder) position(pos int) (int, int) {
+	if pos > dec.length {
+		pos = dec.length
+	}
+	d := dec.data[:pos]
+	i := bytes.LastIndexByte(d, '\n')
+	if i < 0 {
+		return dec.line + 1, dec.column + pos + 1
+	}
+	return dec.line + bytes.Count(d, []byte{'\n'}) + 1, pos - i
+}
+
+// tokenAt returns the JSON token starting at pos in the buffered data.
+func (dec *Decoder) tokenAt(pos int) string {
+	if pos >= dec.length {
+		return ""
+	}
+	end := pos + 1
+	switch c := dec.data[pos]; {
+	case c == '"':
+		for ; end < dec.length; end++ {
+			if dec.data[end] == '\\' {
+				end++
+			} else if dec.data[end] == '"' {
+				end++
+				break
+			}
+		}
+	case isTokenChar(c):
+		for end < dec.length && isTokenChar(dec.data[end]) {
+			end++
+		}
+	}
+	if end > dec.length {
+		end = dec.length
+	}
+	if end-pos > maxTokenLength {
+		end = pos + maxTokenLength
+	}
+	return string(dec.data[pos:end])
+}
+
+func isTokenChar(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '-' || c == '+' || c == '.'
+}
+
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '_' && !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && (i == 0 || !isDigit(c)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,341 @@
+package gojay
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPathItem struct {
+	name  string
+	price int
+}
+
+func (t *testPathItem) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "name":
+		return dec.String(&t.name)
+	case "price":
+		return dec.Int(&t.price)
+	}
+	return nil
+}
+
+func (t *testPathItem) NKeys() int {
+	return 2
+}
+
+type testPathItems []*testPathItem
+
+func (t *testPathItems) UnmarshalJSONArray(dec *Decoder) error {
+	item := &testPathItem{}
+	*t = append(*t, item)
+	return dec.Object(item)
+}
+
+type testPathOrder struct {
+	id    int
+	items testPathItems
+	tags  map[string][]int
+}
+
+func (t *testPathOrder) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "id":
+		return dec.Int(&t.id)
+	case "items":
+		return dec.Array(&t.items)
+	case "tags":
+		t.tags = make(map[string][]int)
+		return dec.Object(DecodeObjectFunc(func(dec *Decoder, k string) error {
+			return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
+				var i int
+				err := dec.Int(&i)
+				t.tags[k] = append(t.tags[k], i)
+				return err
+			}))
+		}))
+	}
+	return nil
+}
+
+func (t *testPathOrder) NKeys() int {
+	return 0
+}
+
+func TestDecodeErrorPath(t *testing.T) {
+	testCases := []struct {
+		name           string
+		json           string
+		expectedPath   string
+		expectedType   string
+		expectedToken  string
+		expectedOffset int
+		expectedLine   int
+		expectedColumn int
+		errType        interface{}
+	}{
+		{
+			name:           "root",
+			json:           `[]`,
+			expectedPath:   "$",
+			expectedType:   "*gojay.testPathOrder",
+			expectedToken:  "[",
+			expectedOffset: 0,
+			expectedLine:   1,
+			expectedColumn: 1,
+			errType:        InvalidUnmarshalError(""),
+		},
+		{
+			name:           "key",
+			json:           `{"id":"1"}`,
+			expectedPath:   "$.id",
+			expectedType:   "*int",
+			expectedToken:  `"1"`,
+			expectedOffset: 6,
+			expectedLine:   1,
+			expectedColumn: 7,
+			errType:        InvalidUnmarshalError(""),
+		},
+		{
+			name:           "array-item",
+			json:           "{\n  \"id\": 1,\n  \"items\": [\n    {\"price\": 1},\n    {\"name\": \"b\", \"price\": true}\n  ]\n}",
+			expectedPath:   "$.items[1].price",
+			expectedType:   "*int",
+			expectedToken:  "true",
+			expectedOffset: 71,
+			expectedLine:   5,
+			expectedColumn: 28,
+			errType:        InvalidUnmarshalError(""),
+		},
+		{
+			name:           "nested-array",
+			json:           `{"tags":{"a b":[1,2],"c":[3,4x]}}`,
+			expectedPath:   `$.tags.c[1]`,
+			expectedType:   "",
+			expectedToken:  "4x",
+			expectedOffset: 28,
+			expectedLine:   1,
+			expectedColumn: 29,
+			errType:        InvalidJSONError(""),
+		},
+		{
+			name:           "quoted-key",
+			json:           `{"tags":{"a b":[1,"2"]}}`,
+			expectedPath:   `$.tags["a b"][1]`,
+			expectedType:   "*int",
+			expectedToken:  `"2"`,
+			expectedOffset: 18,
+			expectedLine:   1,
+			expectedColumn: 19,
+			errType:        InvalidUnmarshalError(""),
+		},
+		{
+			name:           "syntax",
+			json:           "{\"items\":[{\"price\":1}\n,{\"price\":1 x}]}",
+			expectedPath:   "$.items[1].price",
+			expectedType:   "",
+			expectedToken:  "x",
+			expectedOffset: 34,
+			expectedLine:   2,
+			expectedColumn: 13,
+			errType:        InvalidJSONError(""),
+		},
+		{
+			name:           "end-of-input",
+			json:           `{"items":[`,
+			expectedPath:   "$.items[0]",
+			expectedType:   "",
+			expectedToken:  "",
+			expectedOffset: 10,
+			expectedLine:   1,
+			expectedColumn: 11,
+			errType:        InvalidJSONError(""),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := &testPathOrder{}
+			err := UnmarshalJSONObjectWithOptions([]byte(testCase.json), v, DetailedErrors())
+			require.NotNil(t, err, "err should not be nil")
+			var decErr *DecodeError
+			require.True(t, errors.As(err, &decErr), "err should be a *DecodeError")
+			assert.Equal(t, testCase.expectedPath, decErr.Path, "decErr.Path should be equal to expected path")
+			assert.Equal(t, testCase.expectedType, decErr.Type, "decErr.Type should be equal to expected type")
+			assert.Equal(t, testCase.expectedToken, decErr.Token, "decErr.Token should be equal to expected token")
+			assert.Equal(t, testCase.expectedOffset, decErr.Offset, "decErr.Offset should be equal to expected offset")
+			assert.Equal(t, testCase.expectedLine, decErr.Line, "decErr.Line should be equal to expected line")
+			assert.Equal(t, testCase.expectedColumn, decErr.Column, "decErr.Column should be equal to expected column")
+			assert.IsType(t, testCase.errType, decErr.Err, "decErr.Err should be of expected type")
+			assert.True(t, strings.HasPrefix(err.Error(), decErr.Err.Error()), "err message should start with the underlying error message")
+		})
+	}
+}
+
+func TestDecodeErrorNotDetailed(t *testing.T) {
+	v := &testPathOrder{}
+	err := UnmarshalJSONObject([]byte(`{"id":"1"}`), v)
+	assert.IsType(t, InvalidUnmarshalError(""), err, "err should be an InvalidUnmarshalError")
+	err = UnmarshalJSONObject([]byte(`{"id":1x}`), v)
+	assert.IsType(t, InvalidJSONError(""), err, "err should be an InvalidJSONError")
+}
+
+func TestDecodeErrorUnwrap(t *testing.T) {
+	var i int
+	err := UnmarshalWithOptions([]byte(`"a"`), &i, DetailedErrors())
+	var unmarshalErr InvalidUnmarshalError
+	assert.True(t, errors.As(err, &unmarshalErr), "err should wrap an InvalidUnmarshalError")
+	assert.Equal(t, "Cannot unmarshal JSON to type '*int', at $ (line 1, column 1)", err.Error())
+}
+
+func TestDecodeErrorStream(t *testing.T) {
+	dec := Stream.BorrowDecoder(strings.NewReader("{\"id\":1}\n{\"id\":2}\n{\"items\":[{\"price\":null},\n{\"price\":{}}]}"))
+	dec.UseDetailedErrors()
+	c := make(chan *testPathOrder, 3)
+	err := dec.DecodeStream(testPathChannel(c))
+	require.NotNil(t, err, "err should not be nil")
+	var decErr *DecodeError
+	require.True(t, errors.As(err, &decErr), "err should be a *DecodeError")
+	assert.Equal(t, "$.items[1].price", decErr.Path)
+	assert.Equal(t, 53, decErr.Offset)
+	assert.Equal(t, 4, decErr.Line)
+	assert.Equal(t, 10, decErr.Column)
+	assert.Equal(t, "{", decErr.Token)
+}
+
+type testPathChannel chan *testPathOrder
+
+func (c testPathChannel) UnmarshalStream(dec *StreamDecoder) error {
+	v := &testPathOrder{}
+	if err := dec.Object(v); err != nil {
+		return err
+	}
+	c <- v
+	return dec.err
+}
+
+func TestDecoderPath(t *testing.T) {
+	var paths []string
+	dec := BorrowDecoder(strings.NewReader(`{"a":[{"b":1},[2,{"c d":3}]]}`))
+	defer dec.Release()
+	dec.UseDetailedErrors()
+	err := dec.DecodeObject(DecodeObjectFunc(func(dec *Decoder, k string) error {
+		paths = append(paths, dec.Path())
+		return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
+			paths = append(paths, dec.Path())
+			if dec.Index() == 0 {
+				return dec.Object(DecodeObjectFunc(func(dec *Decoder, k string) error {
+					paths = append(paths, dec.Path())
+					return nil
+				}))
+			}
+			return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
+				paths = append(paths, dec.Path())
+				if dec.Index() == 1 {
+					return dec.Object(DecodeObjectFunc(func(dec *Decoder, k string) error {
+						paths = append(paths, dec.Path())
+						return nil
+					}))
+				}
+				var i int
+				return dec.Int(&i)
+			}))
+		}))
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, []string{"$.a", "$.a[0]", "$.a[0].b", "$.a[1]", "$.a[1][0]", "$.a[1][1]", `$.a[1][1]["c d"]`}, paths)
+	assert.Equal(t, "$", dec.Path())
+
+	dec = BorrowDecoder(strings.NewReader(`{"a":[1]}`))
+	defer dec.Release()
+	paths = nil
+	err = dec.DecodeObject(DecodeObjectFunc(func(dec *Decoder, k string) error {
+		paths = append(paths, dec.Path())
+		return dec.Array(DecodeArrayFunc(func(dec *Decoder) error {
+			paths = append(paths, dec.Path())
+			return dec.Skip()
+		}))
+	}))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, []string{"", ""}, paths, "the path should not be tracked without detailed errors")
+}
+
+func TestDecodeAllErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		json          string
+		expectedPaths []string
+		expectedTypes []interface{}
+	}{
+		{
+			name:          "no-error",
+			json:          `{"id":1,"items":[{"name":"a","price":1}]}`,
+			expectedPaths: nil,
+		},
+		{
+			name:          "single",
+			json:          `{"id":"1"}`,
+			expectedPaths: []string{"$.id"},
+			expectedTypes: []interface{}{InvalidUnmarshalError("")},
+		},
+		{
+			name:          "multiple",
+			json:          `{"id":"1","items":[{"name":1,"price":1},{"name":"b","price":true},[]],"tags":{"a":[1,{}]}}`,
+			expectedPaths: []string{"$.id", "$.items[0].name", "$.items[1].price", "$.items[2]", "$.tags.a[1]"},
+			expectedTypes: []interface{}{
+				InvalidUnmarshalError(""),
+				InvalidUnmarshalError(""),
+				InvalidUnmarshalError(""),
+				InvalidUnmarshalError(""),
+				InvalidUnmarshalError(""),
+			},
+		},
+		{
+			name:          "syntax-error",
+			json:          `{"id":"1","items":[{"price":x}]}`,
+			expectedPaths: []string{"$.id", "$.items[0].price"},
+			expectedTypes: []interface{}{InvalidUnmarshalError(""), InvalidJSONError("")},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := &testPathOrder{}
+			err := UnmarshalJSONObjectWithOptions([]byte(testCase.json), v, AllErrors())
+			if testCase.expectedPaths == nil {
+				assert.Nil(t, err, "err should be nil")
+				return
+			}
+			require.IsType(t, DecodeErrors{}, err, "err should be of type DecodeErrors")
+			errs := err.(DecodeErrors)
+			require.Len(t, errs, len(testCase.expectedPaths), "number of errors should be equal to expected")
+			paths := make([]string, len(errs))
+			for i, decErr := range errs {
+				paths[i] = decErr.Path
+				assert.IsType(t, testCase.expectedTypes[i], decErr.Err, "decErr.Err should be of expected type")
+			}
+			assert.Equal(t, testCase.expectedPaths, paths, "paths should be equal to expected paths")
+		})
+	}
+}
+
+func TestDecoderErrors(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(`{"id":"1","items":[{"price":"2"}]}`))
+	dec.UseAllErrors()
+	v := &testPathOrder{}
+	err := dec.DecodeObject(v)
+	assert.Nil(t, err, "err should be nil as decoding went through")
+	errs := dec.Errors()
+	require.Len(t, errs, 2, "two errors should have been collected")
+	assert.Equal(t, "$.id", errs[0].Path)
+	assert.Equal(t, 6, errs[0].Offset)
+	assert.Equal(t, "$.items[0].price", errs[1].Path)
+	assert.Equal(t, 28, errs[1].Offset)
+	assert.Equal(t, `"2"`, errs[1].Token)
+	var decErr *DecodeError
+	assert.True(t, errors.As(DecodeErrors(errs), &decErr), "errors.As should find a *DecodeError")
+	assert.Equal(t, errs[0], decErr)
+	assert.Equal(t, "2 errors occurred; "+errs[0].Error()+"; "+errs[1].Error(), DecodeErrors(errs).Error())
+}
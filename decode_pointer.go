@@ -0,0 +1,81 @@
+package gojay
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParsePointer splits a JSON pointer (RFC 6901), like "/items/3/name", in the path it designates,
+// decoding the escape sequences "~1" to "/" and "~0" to "~".
+// The empty pointer designates the whole document and returns an empty path.
+func ParsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, InvalidPointerError(fmt.Sprintf(invalidPointerErrorMsg, pointer))
+	}
+	path := strings.Split(pointer[1:], "/")
+	for i, p := range path {
+		if strings.IndexByte(p, '~') == -1 {
+			continue
+		}
+		var b strings.Builder
+		for j := 0; j < len(p); j++ {
+			if p[j] != '~' {
+				b.WriteByte(p[j])
+				continue
+			}
+			j++
+			switch {
+			case j < len(p) && p[j] == '0':
+				b.WriteByte('~')
+			case j < len(p) && p[j] == '1':
+				b.WriteByte('/')
+			default:
+				return nil, InvalidPointerError(fmt.Sprintf(invalidPointerErrorMsg, pointer))
+			}
+		}
+		path[i] = b.String()
+	}
+	return path, nil
+}
+
+// GetPointer returns the raw JSON value designated by the JSON pointer (RFC 6901) in data along with its kind.
+// It works like Get, the pointer "/items/3/name" being equivalent to the path "items", "3", "name".
+//
+// If the pointer is malformed, an InvalidPointerError is returned.
+// If it designates no value, like an index out of range or the index "-", ErrPathNotFound is returned.
+func GetPointer(data []byte, pointer string) ([]byte, Kind, error) {
+	path, err := ParsePointer(pointer)
+	if err != nil {
+		return nil, KindInvalid, err
+	}
+	return Get(data, path...)
+}
+
+// UnmarshalAt decodes the value designated by the JSON pointer (RFC 6901) in data to v,
+// which can be of any type accepted by Unmarshal, such as an UnmarshalerJSONObject or an UnmarshalerJSONArray.
+// See GetPointer for the errors returned when the value is not found.
+func UnmarshalAt(data []byte, pointer string, v interface{}) error {
+	b, _, err := GetPointer(data, pointer)
+	if err != nil {
+		return err
+	}
+	// escape sequences are decoded in place, so data must not be decoded directly
+	if bytes.IndexByte(b, '\\') != -1 {
+		b = append([]byte(nil), b...)
+	}
+	return Unmarshal(b, v)
+}
+
+// At returns the raw JSON value designated by the JSON pointer (RFC 6901) in the embedded JSON.
+// The value returned is a sub slice of ej. See GetPointer for the errors returned.
+func (ej EmbeddedJSON) At(pointer string) (EmbeddedJSON, error) {
+	b, _, err := GetPointer(ej, pointer)
+	if err != nil {
+		return nil, err
+	}
+	return EmbeddedJSON(b), nil
+}
@@ -0,0 +1,144 @@
+package gojay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPointerJSON is the example document of RFC 6901.
+const testPointerJSON = `{
+	"foo": ["bar", "baz"],
+	"": 0,
+	"a/b": 1,
+	"c%d": 2,
+	"e^f": 3,
+	"g|h": 4,
+	"i\\j": 5,
+	"k\"l": 6,
+	" ": 7,
+	"m~n": 8
+}`
+
+func TestParsePointer(t *testing.T) {
+	testCases := []struct {
+		pointer  string
+		expected []string
+		err      bool
+	}{
+		{pointer: "", expected: nil},
+		{pointer: "/", expected: []string{""}},
+		{pointer: "/items/3/name", expected: []string{"items", "3", "name"}},
+		{pointer: "/a~1b/m~0n", expected: []string{"a/b", "m~n"}},
+		{pointer: "/~01", expected: []string{"~1"}},
+		{pointer: "//", expected: []string{"", ""}},
+		{pointer: "items", err: true},
+		{pointer: "/a~2", err: true},
+		{pointer: "/a~", err: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.pointer, func(t *testing.T) {
+			path, err := ParsePointer(testCase.pointer)
+			if testCase.err {
+				assert.IsType(t, InvalidPointerError(""), err, "err should be of type InvalidPointerError")
+				return
+			}
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, path)
+		})
+	}
+}
+
+func TestGetPointer(t *testing.T) {
+	testCases := []struct {
+		pointer  string
+		expected string
+		err      error
+	}{
+		{pointer: "", expected: testPointerJSON},
+		{pointer: "/foo", expected: `["bar", "baz"]`},
+		{pointer: "/foo/0", expected: `"bar"`},
+		{pointer: "/", expected: `0`},
+		{pointer: "/a~1b", expected: `1`},
+		{pointer: "/c%d", expected: `2`},
+		{pointer: "/e^f", expected: `3`},
+		{pointer: "/g|h", expected: `4`},
+		{pointer: `/i\j`, expected: `5`},
+		{pointer: `/k"l`, expected: `6`},
+		{pointer: "/ ", expected: `7`},
+		{pointer: "/m~0n", expected: `8`},
+		{pointer: "/foo/2", err: ErrPathNotFound},
+		{pointer: "/foo/-", err: ErrPathNotFound},
+		{pointer: "/foo/01", err: ErrPathNotFound},
+		{pointer: "/foo/+1", err: ErrPathNotFound},
+		{pointer: "/bar", err: ErrPathNotFound},
+		{pointer: "foo", err: InvalidPointerError(`Invalid JSON pointer "foo"`)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.pointer, func(t *testing.T) {
+			data := []byte(testPointerJSON)
+			v, _, err := GetPointer(data, testCase.pointer)
+			if testCase.err != nil {
+				assert.Equal(t, testCase.err, err)
+				return
+			}
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, string(v))
+			assert.Equal(t, testPointerJSON, string(data), "data should not be modified")
+		})
+	}
+}
+
+func TestUnmarshalAt(t *testing.T) {
+	data := []byte(`{"items":[{"name":"Jay","age":30},{"name":"Tom","age":41}],"ids":[1,2,3]}`)
+	v := testTokenObject{}
+	err := UnmarshalAt(data, "/items/1", &v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, testTokenObject{name: "Tom", age: 41}, v)
+
+	var ids testSliceInts
+	err = UnmarshalAt(data, "/ids", &ids)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, testSliceInts{1, 2, 3}, ids)
+
+	var age int
+	err = UnmarshalAt(data, "/items/0/age", &age)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, 30, age)
+
+	err = UnmarshalAt(data, "/items/2", &v)
+	assert.Equal(t, ErrPathNotFound, err)
+
+	err = UnmarshalAt(data, "items", &v)
+	assert.IsType(t, InvalidPointerError(""), err, "err should be of type InvalidPointerError")
+
+	err = UnmarshalAt(data, "/ids", &v)
+	assert.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+}
+
+func TestUnmarshalAtEscaped(t *testing.T) {
+	json := `{"a":{"s":"x\"y\u0041z","o":{"name":"J\u00e9"}}}`
+	data := []byte(json)
+	var s string
+	err := UnmarshalAt(data, "/a/s", &s)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, `x"yAz`, s)
+
+	v := testTokenObject{}
+	err = UnmarshalAt(data, "/a/o", &v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "J\u00e9", v.name)
+	assert.Equal(t, json, string(data), "data should not be modified")
+}
+
+func TestEmbeddedJSONAt(t *testing.T) {
+	ej := EmbeddedJSON(`{"a":{"b/c":[true,{"d":null}]}}`)
+	v, err := ej.At("/a/b~1c/1")
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, EmbeddedJSON(`{"d":null}`), v)
+
+	v, err = ej.At("/a/x")
+	assert.Equal(t, ErrPathNotFound, err)
+	assert.Nil(t, v)
+}
@@ -27,6 +27,7 @@ func NewDecoder(r io.Reader) *Decoder {
 		data:     make([]byte, 512),
 		length:   0,
 		isPooled: 0,
+		maxDepth: DefaultMaxDepth,
 	}
 }
 func newDecoderPool() interface{} {
@@ -49,6 +50,26 @@ func borrowDecoder(r io.Reader, bufSize int) *Decoder {
 	dec.r = r
 	dec.length = 0
 	dec.isPooled = 0
+	dec.useNumber = false
+	dec.strict = false
+	dec.detailed = false
+	dec.allErrors = false
+	dec.disallowUnknown = false
+	dec.caseInsensitiveKeys = false
+	dec.quotedNumbers = false
+	dec.intPolicy = nil
+	dec.maxDepth = DefaultMaxDepth
+	dec.depth = 0
+	dec.maxBytes = 0
+	dec.maxStringLength = 0
+	dec.maxArrayLength = 0
+	dec.maxObjectKeys = 0
+	dec.limitErr = nil
+	dec.errs = nil
+	dec.path = dec.path[:0]
+	dec.offset = 0
+	dec.line = 0
+	dec.column = 0
 	if bufSize > 0 {
 		dec.data = make([]byte, bufSize)
 	}
@@ -0,0 +1,31 @@
+package gojay
+
+// Skip consumes the next value and discards it.
+//
+// Within UnmarshalJSONObject, the value of a key is skipped if no method is called to decode it,
+// Skip allows doing it explicitly: the key is then considered decoded, so it does not make
+// decoding fail when unknown fields are disallowed (see Decoder.DisallowUnknownFields).
+func (dec *Decoder) Skip() error {
+	if err := dec.skipData(); err != nil {
+		return err
+	}
+	dec.called |= 1
+	return nil
+}
+
+// Raw consumes the next value and returns its raw JSON encoding, like `{"a":1}`, `"abc"` or `null`.
+//
+// Unlike EmbeddedJSON, Raw does not copy the value: the slice returned points to the buffer of the Decoder
+// and is only valid until the Decoder reads more input, which can happen on any following call.
+// Copy it to keep it.
+func (dec *Decoder) Raw() ([]byte, error) {
+	if dec.nextChar() == 0 && dec.cursor >= dec.length {
+		return nil, dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	start := dec.cursor
+	if err := dec.skipData(); err != nil {
+		return nil, err
+	}
+	dec.called |= 1
+	return dec.data[start:dec.cursor], nil
+}
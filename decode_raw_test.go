@@ -0,0 +1,111 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRawObject keeps the raw value of key "raw", skips key "skip" and decodes key "name".
+type testRawObject struct {
+	raw  string
+	name string
+}
+
+func (t *testRawObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "raw":
+		raw, err := dec.Raw()
+		if err != nil {
+			return err
+		}
+		t.raw = string(raw)
+		return nil
+	case "skip":
+		return dec.Skip()
+	case "name":
+		return dec.String(&t.name)
+	}
+	return nil
+}
+
+func (t *testRawObject) NKeys() int {
+	return 0
+}
+
+func TestDecoderRaw(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expected string
+		err      bool
+	}{
+		{name: "object", json: `{"raw": {"a": [1, "}"]}, "name":"x"}`, expected: `{"a": [1, "}"]}`},
+		{name: "array", json: `{"raw":[{}, []] ,"name":"x"}`, expected: `[{}, []]`},
+		{name: "string", json: `{"raw":"a\"b","name":"x"}`, expected: `"a\"b"`},
+		{name: "number", json: `{"raw": -1.5e3 ,"name":"x"}`, expected: `-1.5e3`},
+		{name: "true", json: `{"raw":true,"name":"x"}`, expected: `true`},
+		{name: "false", json: `{"raw":false,"name":"x"}`, expected: `false`},
+		{name: "null", json: `{"raw":null,"name":"x"}`, expected: `null`},
+		{name: "invalid", json: `{"raw":x,"name":"x"}`, err: true},
+		{name: "unterminated", json: `{"raw":`, err: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := testRawObject{}
+			err := UnmarshalJSONObject([]byte(testCase.json), &v)
+			if testCase.err {
+				assert.NotNil(t, err, "err should not be nil")
+				return
+			}
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testRawObject{raw: testCase.expected, name: "x"}, v)
+
+			v = testRawObject{}
+			dec := BorrowDecoder(iotest.OneByteReader(strings.NewReader(testCase.json)))
+			defer dec.Release()
+			err = dec.DecodeObject(&v)
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testRawObject{raw: testCase.expected, name: "x"}, v)
+		})
+	}
+}
+
+func TestDecoderRawZeroCopy(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(`[1, {"a":2}]`))
+	var raw []byte
+	err := dec.DecodeArray(DecodeArrayFunc(func(dec *Decoder) error {
+		if k, _ := dec.PeekKind(); k != TokenObjectStart {
+			return dec.Skip()
+		}
+		var err error
+		raw, err = dec.Raw()
+		return err
+	}))
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, `{"a":2}`, string(raw))
+	assert.Equal(t, &dec.data[4], &raw[0], "raw should point to the buffer of the decoder")
+}
+
+func TestDecoderSkip(t *testing.T) {
+	v := testRawObject{}
+	err := UnmarshalWithOptions([]byte(`{"skip":{"a":[1,2]},"name":"x"}`), &v, DisallowUnknownFields())
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, testRawObject{name: "x"}, v)
+
+	err = UnmarshalWithOptions([]byte(`{"skip":1,"other":2}`), &v, DisallowUnknownFields())
+	assert.IsType(t, &UnknownFieldError{}, err, "err should be of type *UnknownFieldError")
+
+	err = UnmarshalJSONObject([]byte(`{"skip":x,"name":"x"}`), &v)
+	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+
+	dec := BorrowDecoder(strings.NewReader(`{"a":1} "b"`))
+	defer dec.Release()
+	require.Nil(t, dec.Skip(), "err should be nil")
+	var s string
+	require.Nil(t, dec.Decode(&s), "err should be nil")
+	assert.Equal(t, "b", s)
+}
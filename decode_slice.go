@@ -87,3 +87,44 @@ func (dec *Decoder) SliceBool(s *[]bool) error {
 	}
 	return nil
 }
+
+// SliceDecoder decodes a JSON array of objects to a *[]T, each element being allocated by calling
+// the constructor given to NewSliceDecoder. It is the decoding counterpart of Slice:
+//
+//	var users []*User
+//	err := gojay.UnmarshalJSONArray(data, gojay.NewSliceDecoder(&users, func() *User { return &User{} }))
+type SliceDecoder[T UnmarshalerJSONObject] struct {
+	s    *[]T
+	newT func() T
+}
+
+// NewSliceDecoder returns a SliceDecoder appending the decoded elements to s, each element is allocated by calling newT.
+func NewSliceDecoder[T UnmarshalerJSONObject](s *[]T, newT func() T) *SliceDecoder[T] {
+	return &SliceDecoder[T]{s: s, newT: newT}
+}
+
+// UnmarshalJSONArray implements UnmarshalerJSONArray
+func (d *SliceDecoder[T]) UnmarshalJSONArray(dec *Decoder) error {
+	v := d.newT()
+	if err := dec.Object(v); err != nil {
+		return err
+	}
+	*d.s = append(*d.s, v)
+	return nil
+}
+
+// DecodeSlice unmarshals the next JSON array of objects to the given *[]T s,
+// each element is allocated by calling newT and decoded with Decoder.Object.
+//
+// It saves declaring a named slice type implementing UnmarshalerJSONArray:
+//
+//	func (u *Users) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+//		switch k {
+//		case "users":
+//			return gojay.DecodeSlice(dec, &u.users, func() *User { return &User{} })
+//		}
+//		return nil
+//	}
+func DecodeSlice[T UnmarshalerJSONObject](dec *Decoder, s *[]T, newT func() T) error {
+	return dec.Array(NewSliceDecoder(s, newT))
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -123,3 +124,77 @@ func TestDecodeSlices(t *testing.T) {
 		)
 	}
 }
+
+func TestDecodeSliceObjects(t *testing.T) {
+	testCases := []struct {
+		name           string
+		json           string
+		expectedResult []*testPathItem
+		err            bool
+	}{
+		{
+			name: "basic",
+			json: `[{"name":"foo","price":1},{"name":"bar"}]`,
+			expectedResult: []*testPathItem{
+				{name: "foo", price: 1},
+				{name: "bar"},
+			},
+		},
+		{
+			name:           "empty",
+			json:           `[]`,
+			expectedResult: nil,
+		},
+		{
+			name:           "null",
+			json:           `null`,
+			expectedResult: nil,
+		},
+		{
+			name: "err item",
+			json: `[{"name":"foo","price":"1"}]`,
+			err:  true,
+		},
+		{
+			name: "err syntax",
+			json: `[{"name":"foo"},}]`,
+			err:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				dec := BorrowDecoder(strings.NewReader(`{"items":` + testCase.json + `}`))
+				defer dec.Release()
+				var s []*testPathItem
+				err := dec.Decode(DecodeObjectFunc(func(dec *Decoder, k string) error {
+					return DecodeSlice(dec, &s, func() *testPathItem { return &testPathItem{} })
+				}))
+
+				if testCase.err {
+					require.NotNil(t, err, "err should not be nil")
+					return
+				}
+				require.Nil(t, err, "err should be nil")
+				require.Equal(t, testCase.expectedResult, s)
+			},
+		)
+	}
+}
+
+func TestSliceDecoderRoundTrip(t *testing.T) {
+	items := []*testPathItem{{name: "foo", price: 1}, {name: "bar"}}
+	data, err := MarshalJSONArray(Slice[*testPathItem](items))
+	require.Nil(t, err, "err should be nil")
+
+	var s []*testPathItem
+	err = UnmarshalJSONArray(data, NewSliceDecoder(&s, func() *testPathItem { return &testPathItem{} }))
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, items, s)
+
+	s = nil
+	err = UnmarshalJSONArray([]byte(`[{"name":"foo"},1]`), NewSliceDecoder(&s, func() *testPathItem { return &testPathItem{} }))
+	assert.IsType(t, InvalidUnmarshalError(""), err, "err should be of type InvalidUnmarshalError")
+}
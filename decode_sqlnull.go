@@ -7,6 +7,9 @@ func (dec *Decoder) DecodeSQLNullString(v *sql.NullString) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeSQLNullString(v)
 }
 
@@ -25,6 +28,9 @@ func (dec *Decoder) DecodeSQLNullInt64(v *sql.NullInt64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeSQLNullInt64(v)
 }
 
@@ -43,6 +49,9 @@ func (dec *Decoder) DecodeSQLNullFloat64(v *sql.NullFloat64) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeSQLNullFloat64(v)
 }
 
@@ -61,6 +70,9 @@ func (dec *Decoder) DecodeSQLNullBool(v *sql.NullBool) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeSQLNullBool(v)
 }
 
@@ -47,6 +47,11 @@ func (dec *StreamDecoder) DecodeStream(c UnmarshalerStream) error {
 		default:
 			// char is not space start reading
 			for dec.nextChar() != 0 {
+				if err := dec.checkStrict(); err != nil {
+					dec.err = err
+					close(dec.done)
+					return err
+				}
 				// calling unmarshal stream
 				err := c.UnmarshalStream(dec)
 				if err != nil {
@@ -56,10 +61,17 @@ func (dec *StreamDecoder) DecodeStream(c UnmarshalerStream) error {
 				}
 				// garbage collects buffer
 				// we don't want the buffer to grow extensively
+				dec.discard(dec.cursor)
 				dec.data = dec.data[dec.cursor:]
 				dec.length = dec.length - dec.cursor
 				dec.cursor = 0
 			}
+			// the input is cut when the maximum size is exceeded
+			if dec.limitErr != nil {
+				dec.err = dec.limitErr
+				close(dec.done)
+				return dec.err
+			}
 			// close the done channel to signal the end of the job
 			close(dec.done)
 			return nil
@@ -43,6 +43,26 @@ func (s stream) borrowDecoder(r io.Reader, bufSize int) *StreamDecoder {
 	streamDec.r = r
 	streamDec.length = 0
 	streamDec.isPooled = 0
+	streamDec.useNumber = false
+	streamDec.strict = false
+	streamDec.detailed = false
+	streamDec.allErrors = false
+	streamDec.disallowUnknown = false
+	streamDec.caseInsensitiveKeys = false
+	streamDec.quotedNumbers = false
+	streamDec.intPolicy = nil
+	streamDec.maxDepth = DefaultMaxDepth
+	streamDec.depth = 0
+	streamDec.maxBytes = 0
+	streamDec.maxStringLength = 0
+	streamDec.maxArrayLength = 0
+	streamDec.maxObjectKeys = 0
+	streamDec.limitErr = nil
+	streamDec.errs = nil
+	streamDec.path = streamDec.path[:0]
+	streamDec.offset = 0
+	streamDec.line = 0
+	streamDec.column = 0
 	streamDec.done = make(chan struct{}, 1)
 	if bufSize > 0 {
 		streamDec.data = make([]byte, bufSize)
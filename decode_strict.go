@@ -0,0 +1,269 @@
+package gojay
+
+// DecoderOption is a function configuring a Decoder.
+// Options are given to the WithOptions variants of the Unmarshal functions.
+type DecoderOption func(*Decoder)
+
+func (dec *Decoder) applyOptions(opts []DecoderOption) {
+	for _, opt := range opts {
+		opt(dec)
+	}
+}
+
+// Strict returns a DecoderOption enabling the strict mode of the Decoder.
+// See Decoder.UseStrict.
+func Strict() DecoderOption {
+	return func(dec *Decoder) {
+		dec.UseStrict()
+	}
+}
+
+// UseStrict enables the strict mode of the Decoder.
+//
+// By default, the Decoder is lenient and repairs some malformed JSON, commas being handled like white spaces.
+// In strict mode, every value is validated against RFC 8259 before being decoded:
+//   - elements of objects and arrays must be separated by exactly one comma,
+//     leading, trailing and repeated commas are rejected
+//   - numbers and literals (true, false, null) must follow the JSON grammar
+//   - strings must not contain control characters and escape sequences must be valid
+//
+// When unmarshaling a []byte, nothing but white spaces may follow the top level value.
+//
+// An InvalidJSONError is returned when the input is malformed.
+func (dec *Decoder) UseStrict() {
+	dec.strict = true
+}
+
+// checkStrict validates the next JSON value if the decoder is in strict mode.
+// The cursor is left untouched so that the value can be decoded afterwards.
+func (dec *Decoder) checkStrict() error {
+	if !dec.strict {
+		return nil
+	}
+	start := dec.cursor
+	if err := dec.assertValue(); err != nil {
+		return err
+	}
+	dec.cursor = start
+	return nil
+}
+
+// checkStrictDocument validates the whole input if the decoder is in strict mode,
+// making sure there is a single JSON value.
+func (dec *Decoder) checkStrictDocument() error {
+	if !dec.strict {
+		return nil
+	}
+	start := dec.cursor
+	if err := dec.assertValue(); err != nil {
+		return err
+	}
+	if dec.skipSpaces() != 0 {
+		return dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	dec.cursor = start
+	return nil
+}
+
+// assertValue moves the cursor after the next JSON value, returning an error if it is malformed.
+func (dec *Decoder) assertValue() error {
+	switch dec.skipSpaces() {
+	case '{':
+		dec.cursor++
+		if err := dec.incDepth(); err != nil {
+			return err
+		}
+		err := dec.assertObject()
+		dec.depth--
+		return err
+	case '[':
+		dec.cursor++
+		if err := dec.incDepth(); err != nil {
+			return err
+		}
+		err := dec.assertArray()
+		dec.depth--
+		return err
+	case '"':
+		dec.cursor++
+		return dec.assertString()
+	case 't':
+		return dec.assertLiteral("true")
+	case 'f':
+		return dec.assertLiteral("false")
+	case 'n':
+		return dec.assertLiteral("null")
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return dec.assertNumber()
+	}
+	return dec.raiseInvalidJSONErr(dec.cursor)
+}
+
+func (dec *Decoder) assertObject() error {
+	if dec.skipSpaces() == '}' {
+		dec.cursor++
+		return nil
+	}
+	for n := 1; ; n++ {
+		if dec.skipSpaces() != '"' {
+			return dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		if exceedsLimit(dec.maxObjectKeys, n) {
+			return dec.raiseLimitErr(LimitObjectKeys, dec.maxObjectKeys, dec.cursor)
+		}
+		dec.cursor++
+		if err := dec.assertString(); err != nil {
+			return err
+		}
+		if dec.skipSpaces() != ':' {
+			return dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		dec.cursor++
+		if err := dec.assertValue(); err != nil {
+			return err
+		}
+		switch dec.skipSpaces() {
+		case ',':
+			dec.cursor++
+		case '}':
+			dec.cursor++
+			return nil
+		default:
+			return dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+}
+
+func (dec *Decoder) assertArray() error {
+	if dec.skipSpaces() == ']' {
+		dec.cursor++
+		return nil
+	}
+	for n := 1; ; n++ {
+		if exceedsLimit(dec.maxArrayLength, n) {
+			return dec.raiseLimitErr(LimitArrayLength, dec.maxArrayLength, dec.cursor)
+		}
+		if err := dec.assertValue(); err != nil {
+			return err
+		}
+		switch dec.skipSpaces() {
+		case ',':
+			dec.cursor++
+		case ']':
+			dec.cursor++
+			return nil
+		default:
+			return dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+}
+
+// assertString moves the cursor after the closing quote of the string, the cursor must be right after the opening quote.
+func (dec *Decoder) assertString() error {
+	start := dec.cursor
+	// n counts escape sequences as one byte, it is never more than the decoded length
+	var n int
+	for dec.cursor < dec.length || dec.read() {
+		c := dec.data[dec.cursor]
+		dec.cursor++
+		if c != '"' {
+			if n++; exceedsLimit(dec.maxStringLength, n) {
+				return dec.raiseLimitErr(LimitStringLength, dec.maxStringLength, start-1)
+			}
+		}
+		switch {
+		case c == '"':
+			return nil
+		case c < 0x20:
+			return dec.raiseInvalidJSONErr(dec.cursor - 1)
+		case c == '\\':
+			if dec.cursor >= dec.length && !dec.read() {
+				return dec.raiseInvalidJSONErr(dec.cursor)
+			}
+			switch dec.data[dec.cursor] {
+			case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+				dec.cursor++
+			case 'u':
+				dec.cursor++
+				for i := 0; i < 4; i++ {
+					if dec.cursor >= dec.length && !dec.read() {
+						return dec.raiseInvalidJSONErr(dec.cursor)
+					}
+					if !isHexDigit(dec.data[dec.cursor]) {
+						return dec.raiseInvalidJSONErr(dec.cursor)
+					}
+					dec.cursor++
+				}
+			default:
+				return dec.raiseInvalidJSONErr(dec.cursor)
+			}
+		}
+	}
+	return dec.raiseInvalidJSONErr(dec.cursor)
+}
+
+func (dec *Decoder) assertLiteral(lit string) error {
+	for i := 0; i < len(lit); i++ {
+		if (dec.cursor >= dec.length && !dec.read()) || dec.data[dec.cursor] != lit[i] {
+			return dec.raiseInvalidJSONErr(dec.cursor)
+		}
+		dec.cursor++
+	}
+	return nil
+}
+
+// assertNumber moves the cursor after the number, enforcing the grammar:
+//
+//	[ minus ] int [ frac ] [ exp ]
+func (dec *Decoder) assertNumber() error {
+	if dec.data[dec.cursor] == '-' {
+		dec.cursor++
+	}
+	// int: a single zero or a non zero digit followed by digits
+	if dec.cursor >= dec.length && !dec.read() {
+		return dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	switch dec.data[dec.cursor] {
+	case '0':
+		dec.cursor++
+	case '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		dec.cursor++
+		dec.assertDigits()
+	default:
+		return dec.raiseInvalidJSONErr(dec.cursor)
+	}
+	// frac
+	if (dec.cursor < dec.length || dec.read()) && dec.data[dec.cursor] == '.' {
+		dec.cursor++
+		if dec.assertDigits() == 0 {
+			return dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+	// exp
+	if (dec.cursor < dec.length || dec.read()) && (dec.data[dec.cursor] == 'e' || dec.data[dec.cursor] == 'E') {
+		dec.cursor++
+		if (dec.cursor < dec.length || dec.read()) && (dec.data[dec.cursor] == '+' || dec.data[dec.cursor] == '-') {
+			dec.cursor++
+		}
+		if dec.assertDigits() == 0 {
+			return dec.raiseInvalidJSONErr(dec.cursor)
+		}
+	}
+	return nil
+}
+
+// assertDigits moves the cursor after a sequence of digits and returns the number of digits found.
+func (dec *Decoder) assertDigits() int {
+	n := 0
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		if !isDigit(dec.data[dec.cursor]) {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+func isHexDigit(b byte) bool {
+	return isDigit(b) || (b >= 'a' && b <= 'f') || (b >= 'A' && b <= 'F')
+}
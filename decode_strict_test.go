@@ -0,0 +1,98 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoderStrict(t *testing.T) {
+	testCases := []struct {
+		name string
+		json string
+		err  bool
+	}{
+		{name: "valid", json: ` {"testStr": "a\"bé", "testInt": -1, "testFloat64": 1.5e-3, "testBool": true, "testInterface": [null, false, {}]} `},
+		{name: "valid-crlf", json: "{\"testBool\": true\r\n,\"testInt\": 1\r\n}"},
+		{name: "repeated-commas", json: `{"testInt":1,,,"testStr":"a"}`, err: true},
+		{name: "leading-comma", json: `{,"testInt":1}`, err: true},
+		{name: "trailing-comma", json: `{"testInt":1,}`, err: true},
+		{name: "missing-comma", json: `{"testInt":1 "testStr":"a"}`, err: true},
+		{name: "missing-comma-array", json: `{"testInterface":[1 2 3]}`, err: true},
+		{name: "trailing-garbage", json: `{"testInt":1}}`, err: true},
+		{name: "trailing-value", json: `{"testInt":1} {}`, err: true},
+		{name: "leading-zero", json: `{"testInt":01}`, err: true},
+		{name: "empty-fraction", json: `{"testFloat64":1.}`, err: true},
+		{name: "empty-exponent", json: `{"testFloat64":1e}`, err: true},
+		{name: "double-minus", json: `{"testInt":--1}`, err: true},
+		{name: "bad-literal", json: `{"testBool":tru}`, err: true},
+		{name: "bad-literal-suffix", json: `{"testBool":truex}`, err: true},
+		{name: "bad-escape", json: `{"testStr":"\x"}`, err: true},
+		{name: "bad-unicode-escape", json: `{"testStr":"\u12g4"}`, err: true},
+		{name: "control-char", json: "{\"testStr\":\"a\tb\"}", err: true},
+		{name: "unknown-key-malformed", json: `{"unknown":[1,,2],"testInt":1}`, err: true},
+		{name: "unterminated", json: `{"testInt":1`, err: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := &testObject{}
+			err := UnmarshalJSONObjectWithOptions([]byte(testCase.json), v, Strict())
+			if testCase.err {
+				assert.NotNil(t, err, "err should not be nil")
+				assert.IsType(t, InvalidJSONError(""), err, "err should be an InvalidJSONError")
+				return
+			}
+			assert.Nil(t, err, "err should be nil")
+		})
+	}
+}
+
+func TestDecoderStrictLenientByDefault(t *testing.T) {
+	v := &testObject{}
+	err := UnmarshalJSONObject([]byte(`{"testInt":1,,,"testStr":"a",}`), v)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, 1, v.testInt, "v.testInt should be 1")
+	assert.Equal(t, "a", v.testStr, "v.testStr should be a")
+}
+
+func TestDecoderStrictArray(t *testing.T) {
+	var s testSliceInts
+	err := UnmarshalJSONArrayWithOptions([]byte(`[1,2,3]`), &s, Strict())
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, testSliceInts{1, 2, 3}, s)
+
+	s = nil
+	err = UnmarshalJSONArrayWithOptions([]byte(`[1 2 3]`), &s, Strict())
+	assert.IsType(t, InvalidJSONError(""), err, "err should be an InvalidJSONError")
+
+	s = nil
+	err = UnmarshalJSONArray([]byte(`[1 2 3]`), &s)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, testSliceInts{1, 2, 3}, s)
+}
+
+func TestDecoderStrictUnmarshal(t *testing.T) {
+	var i int
+	err := UnmarshalWithOptions([]byte(` 12 `), &i, Strict())
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, 12, i)
+
+	err = UnmarshalWithOptions([]byte(`12 1`), &i, Strict())
+	assert.IsType(t, InvalidJSONError(""), err, "err should be an InvalidJSONError")
+
+	var str string
+	err = UnmarshalWithOptions([]byte(`,"a"`), &str, Strict())
+	assert.IsType(t, InvalidJSONError(""), err, "err should be an InvalidJSONError")
+}
+
+func TestDecoderStrictReader(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(`{"testInt":1} {"testInt":2,}`))
+	dec.UseStrict()
+	v := &testObject{}
+	err := dec.DecodeObject(v)
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, 1, v.testInt)
+	err = dec.DecodeObject(v)
+	assert.IsType(t, InvalidJSONError(""), err, "err should be an InvalidJSONError")
+}
@@ -7,6 +7,9 @@ func (dec *Decoder) DecodeString(v *string) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeString(v)
 }
 func (dec *Decoder) decodeString(v *string) error {
@@ -35,8 +38,7 @@ func (dec *Decoder) decodeString(v *string) error {
 			}
 			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -76,8 +78,7 @@ func (dec *Decoder) decodeStringNull(v **string) error {
 			}
 			return nil
 		default:
-			dec.err = dec.makeInvalidUnmarshalErr(v)
-			err := dec.skipData()
+			err := dec.skipInvalidValue(v, dec.makeInvalidUnmarshalErr)
 			if err != nil {
 				return err
 			}
@@ -116,8 +117,10 @@ func (dec *Decoder) parseEscapedString() error {
 			return err
 		}
 		diff := dec.cursor - start
-		dec.data = append(append(dec.data[:start-1], str...), dec.data[dec.cursor:]...)
-		dec.length = len(dec.data)
+		// the buffer may not be full when streaming, so only the bytes read are moved
+		n := copy(dec.data[start-1:], str)
+		n += copy(dec.data[start-1+n:], dec.data[dec.cursor:dec.length])
+		dec.length = start - 1 + n
 		dec.cursor += len(str) - diff - 1
 
 		return nil
@@ -154,7 +157,10 @@ func (dec *Decoder) getString() (int, int, error) {
 			}
 		default:
 			dec.cursor = dec.cursor + 1
-			continue
+		}
+		// escape sequences are decoded in place, so this is the decoded length
+		if exceedsLimit(dec.maxStringLength, dec.cursor-keyStart) {
+			return 0, 0, dec.raiseLimitErr(LimitStringLength, dec.maxStringLength, keyStart-1)
 		}
 	}
 	return 0, 0, dec.raiseInvalidJSONErr(dec.cursor)
@@ -195,6 +201,9 @@ func (dec *Decoder) skipEscapedString() error {
 }
 
 func (dec *Decoder) skipString() error {
+	if dec.maxStringLength > 0 {
+		return dec.skipStringLimited()
+	}
 	for dec.cursor < dec.length || dec.read() {
 		switch dec.data[dec.cursor] {
 		// found the closing quote
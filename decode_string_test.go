@@ -5,6 +5,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -659,6 +660,17 @@ func TestDecoderStringDecoderAPI(t *testing.T) {
 	assert.Equal(t, "hello world!", v, "v must be equal to 'hello world!'")
 }
 
+func TestDecoderStringUnicodeStream(t *testing.T) {
+	var v, w string
+	dec := NewDecoder(iotest.OneByteReader(strings.NewReader(`"a\u00e9b\ud83d\ude00c" "d"`)))
+	err := dec.DecodeString(&v)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "a\u00e9b\U0001F600c", v, "v must be equal to 'a\u00e9b\U0001F600c'")
+	err = dec.DecodeString(&w)
+	assert.Nil(t, err, "Err must be nil")
+	assert.Equal(t, "d", w, "w must be equal to 'd'")
+}
+
 func TestDecoderStringPoolError(t *testing.T) {
 	// reset the pool to make sure it's not full
 	decPool = sync.Pool{
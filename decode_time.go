@@ -9,6 +9,9 @@ func (dec *Decoder) DecodeTime(v *time.Time, format string) error {
 	if dec.isPooled == 1 {
 		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
 	}
+	if err := dec.checkStrict(); err != nil {
+		return err
+	}
 	return dec.decodeTime(v, format)
 }
 
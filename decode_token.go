@@ -0,0 +1,263 @@
+package gojay
+
+import "io"
+
+// TokenKind is the kind of a Token.
+type TokenKind byte
+
+const (
+	// TokenInvalid is the kind of the zero Token, returned along with errors.
+	TokenInvalid TokenKind = iota
+	// TokenObjectStart is the kind of a `{` delimiter.
+	TokenObjectStart
+	// TokenObjectEnd is the kind of a `}` delimiter.
+	TokenObjectEnd
+	// TokenArrayStart is the kind of a `[` delimiter.
+	TokenArrayStart
+	// TokenArrayEnd is the kind of a `]` delimiter.
+	TokenArrayEnd
+	// TokenKey is the kind of a string followed by a colon, the key of an object.
+	TokenKey
+	// TokenString is the kind of a string value.
+	TokenString
+	// TokenNumber is the kind of a number.
+	TokenNumber
+	// TokenBool is the kind of `true` and `false`.
+	TokenBool
+	// TokenNull is the kind of `null`.
+	TokenNull
+)
+
+var tokenKindNames = [...]string{
+	TokenInvalid:     "invalid",
+	TokenObjectStart: "object start",
+	TokenObjectEnd:   "object end",
+	TokenArrayStart:  "array start",
+	TokenArrayEnd:    "array end",
+	TokenKey:         "key",
+	TokenString:      "string",
+	TokenNumber:      "number",
+	TokenBool:        "bool",
+	TokenNull:        "null",
+}
+
+// String returns the name of the kind, like "object start" or "number".
+func (k TokenKind) String() string {
+	if int(k) < len(tokenKindNames) {
+		return tokenKindNames[k]
+	}
+	return tokenKindNames[TokenInvalid]
+}
+
+// Token is a token of the JSON input returned by Decoder.Token.
+//
+// Value holds the delimiter for delimiters, the unescaped content for keys and strings
+// and the literal for numbers, booleans and null.
+// It points to the buffer of the Decoder and is only valid until the next call to the Decoder,
+// copy it to keep it.
+type Token struct {
+	Kind  TokenKind
+	Value []byte
+}
+
+// Token returns the next JSON token of the input, it returns io.EOF at the end of the input.
+//
+// Token reads the same buffer as the other decoding methods, so it is possible to switch between
+// tokens and values in the middle of the input: after a TokenKey or a TokenArrayStart,
+// the next value can be decoded with methods such as dec.Object or dec.String, and Token
+// continues after it. Commas and colons are not returned.
+func (dec *Decoder) Token() (Token, error) {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	switch c := dec.nextToken(); c {
+	case '{', '[':
+		dec.cursor++
+		if err := dec.incDepth(); err != nil {
+			return Token{}, err
+		}
+		kind := TokenObjectStart
+		if c == '[' {
+			kind = TokenArrayStart
+		}
+		return Token{Kind: kind, Value: dec.data[dec.cursor-1 : dec.cursor]}, nil
+	case '}', ']':
+		dec.cursor++
+		if dec.depth > 0 {
+			dec.depth--
+		}
+		kind := TokenObjectEnd
+		if c == ']' {
+			kind = TokenArrayEnd
+		}
+		return Token{Kind: kind, Value: dec.data[dec.cursor-1 : dec.cursor]}, nil
+	case '"':
+		dec.cursor++
+		start, end, err := dec.getString()
+		if err != nil {
+			return Token{}, err
+		}
+		dec.cursor = end
+		// we do minus one to remove the last quote
+		v := dec.data[start : end-1]
+		if dec.skipColon() {
+			return Token{Kind: TokenKey, Value: v}, nil
+		}
+		return Token{Kind: TokenString, Value: v}, nil
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		start := dec.cursor
+		end := dec.cursor + 1
+		for ; end < dec.length || dec.read(); end++ {
+			if skipNumberEndCursorIncrement[dec.data[end]] == 0 {
+				break
+			}
+		}
+		if !isNumberLiteral(dec.data[start:end]) {
+			return Token{}, dec.raiseInvalidJSONErr(start)
+		}
+		dec.cursor = end
+		return Token{Kind: TokenNumber, Value: dec.data[start:end]}, nil
+	case 't', 'f', 'n':
+		start := dec.cursor
+		dec.cursor++
+		var err error
+		kind := TokenBool
+		switch c {
+		case 't':
+			err = dec.assertTrue()
+		case 'f':
+			err = dec.assertFalse()
+		default:
+			kind = TokenNull
+			err = dec.assertNull()
+		}
+		if err != nil {
+			return Token{}, err
+		}
+		return Token{Kind: kind, Value: dec.data[start:dec.cursor]}, nil
+	default:
+		if dec.cursor >= dec.length {
+			return Token{}, dec.eofErr()
+		}
+		return Token{}, dec.raiseInvalidJSONErr(dec.cursor)
+	}
+}
+
+// PeekKind returns the kind of the token Token would return, without consuming it,
+// reading from the io.Reader if needed. It returns io.EOF at the end of the input.
+//
+// Within UnmarshalJSONObject or UnmarshalJSONArray, it allows choosing the method decoding a value
+// which can be of several types:
+//
+//	func (u *user) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+//		if k == "address" {
+//			if kind, _ := dec.PeekKind(); kind == gojay.TokenString {
+//				return dec.String(&u.addressLine)
+//			}
+//			return dec.Object(&u.address)
+//		}
+//		return nil
+//	}
+func (dec *Decoder) PeekKind() (TokenKind, error) {
+	if dec.isPooled == 1 {
+		panic(InvalidUsagePooledDecoderError("Invalid usage of pooled decoder"))
+	}
+	switch c := dec.nextToken(); c {
+	case '"':
+		// look for the closing quote and the colon without moving the cursor,
+		// as unescaping the string would modify the buffer
+		j := dec.cursor + 1
+		escaped := false
+		for ; j < dec.length || dec.read(); j++ {
+			if escaped {
+				escaped = false
+				continue
+			}
+			if dec.data[j] == '\\' {
+				escaped = true
+				continue
+			}
+			if dec.data[j] == '"' {
+				break
+			}
+		}
+		for j++; j < dec.length || dec.read(); j++ {
+			switch dec.data[j] {
+			case ' ', '\n', '\t', '\r':
+				continue
+			case ':':
+				return TokenKey, nil
+			}
+			break
+		}
+		return TokenString, nil
+	default:
+		if kind := tokenKindOf(c); kind != TokenInvalid {
+			return kind, nil
+		}
+		if dec.cursor >= dec.length {
+			return TokenInvalid, dec.eofErr()
+		}
+		return TokenInvalid, dec.raiseInvalidJSONErr(dec.cursor)
+	}
+}
+
+// tokenKindOf returns the kind of the token starting with the char c, a string being a TokenString.
+func tokenKindOf(c byte) TokenKind {
+	switch c {
+	case '{':
+		return TokenObjectStart
+	case '}':
+		return TokenObjectEnd
+	case '[':
+		return TokenArrayStart
+	case ']':
+		return TokenArrayEnd
+	case '"':
+		return TokenString
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return TokenNumber
+	case 't', 'f':
+		return TokenBool
+	case 'n':
+		return TokenNull
+	}
+	return TokenInvalid
+}
+
+// nextToken moves the cursor to the next char which is not a space nor a separator and returns it.
+// It returns 0 at the end of the input.
+func (dec *Decoder) nextToken() byte {
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		switch dec.data[dec.cursor] {
+		case ' ', '\n', '\t', '\r', ',', ':':
+			continue
+		}
+		return dec.data[dec.cursor]
+	}
+	return 0
+}
+
+// skipColon moves the cursor after the colon following a key and reports if one was found.
+func (dec *Decoder) skipColon() bool {
+	for ; dec.cursor < dec.length || dec.read(); dec.cursor++ {
+		switch dec.data[dec.cursor] {
+		case ' ', '\n', '\t', '\r':
+			continue
+		case ':':
+			dec.cursor++
+			return true
+		}
+		return false
+	}
+	return false
+}
+
+// eofErr returns the error to return once the input is consumed,
+// it is the LimitError if the input was cut because it exceeded the limit.
+func (dec *Decoder) eofErr() error {
+	if dec.limitErr != nil {
+		return dec.limitErr
+	}
+	return io.EOF
+}
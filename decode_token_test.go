@@ -0,0 +1,359 @@
+package gojay
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTokenObject struct {
+	name string
+	age  int
+}
+
+func (t *testTokenObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "name":
+		return dec.String(&t.name)
+	case "age":
+		return dec.Int(&t.age)
+	}
+	return nil
+}
+
+func (t *testTokenObject) NKeys() int {
+	return 0
+}
+
+// readTokens returns all the tokens of the input as "kind:value" strings.
+func readTokens(dec *Decoder) ([]string, error) {
+	var tokens []string
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return tokens, nil
+		}
+		if err != nil {
+			return tokens, err
+		}
+		tokens = append(tokens, string('0'+byte(tok.Kind))+":"+string(tok.Value))
+	}
+}
+
+func TestDecoderToken(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expected []string
+		err      bool
+	}{
+		{
+			name: "object",
+			json: `{"a": "b", "c" : 1.5e3, "d":[true, false, null], "e":{}}`,
+			expected: []string{
+				"1:{", "5:a", "6:b", "5:c", "7:1.5e3", "5:d",
+				"3:[", "8:true", "8:false", "9:null", "4:]",
+				"5:e", "1:{", "2:}", "2:}",
+			},
+		},
+		{
+			name:     "array-of-strings",
+			json:     `["a", "b",-12]`,
+			expected: []string{"3:[", "6:a", "6:b", "7:-12", "4:]"},
+		},
+		{
+			name:     "escaped",
+			json:     `{"a\"b":"c\ndé"}`,
+			expected: []string{"1:{", "5:a\"b", "6:c\ndé", "2:}"},
+		},
+		{
+			name:     "several-values",
+			json:     ` 1 "a" {} `,
+			expected: []string{"7:1", "6:a", "1:{", "2:}"},
+		},
+		{
+			name:     "empty",
+			json:     ` `,
+			expected: nil,
+		},
+		{
+			name:     "invalid-char",
+			json:     `[1, x]`,
+			expected: []string{"3:[", "7:1"},
+			err:      true,
+		},
+		{
+			name:     "invalid-number",
+			json:     `[01]`,
+			expected: []string{"3:["},
+			err:      true,
+		},
+		{
+			name:     "invalid-literal",
+			json:     `[nul]`,
+			expected: []string{"3:["},
+			err:      true,
+		},
+		{
+			name:     "unterminated-string",
+			json:     `["abc`,
+			expected: []string{"3:["},
+			err:      true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			for _, r := range []io.Reader{
+				strings.NewReader(testCase.json),
+				iotest.OneByteReader(strings.NewReader(testCase.json)),
+			} {
+				dec := BorrowDecoder(r)
+				tokens, err := readTokens(dec)
+				dec.Release()
+				if testCase.err {
+					assert.NotNil(t, err, "err should not be nil")
+					assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+				} else {
+					assert.Nil(t, err, "err should be nil")
+				}
+				assert.Equal(t, testCase.expected, tokens)
+			}
+		})
+	}
+}
+
+func TestDecoderPeekKind(t *testing.T) {
+	dec := BorrowDecoder(iotest.OneByteReader(strings.NewReader(`{"a\"" : "b", "c":[1,true,null]}`)))
+	defer dec.Release()
+	expected := []TokenKind{
+		TokenObjectStart, TokenKey, TokenString, TokenKey, TokenArrayStart,
+		TokenNumber, TokenBool, TokenNull, TokenArrayEnd, TokenObjectEnd,
+	}
+	for _, kind := range expected {
+		k, err := dec.PeekKind()
+		require.Nil(t, err, "err should be nil")
+		assert.Equal(t, kind, k)
+		// peeking twice does not consume the token
+		k, err = dec.PeekKind()
+		require.Nil(t, err, "err should be nil")
+		assert.Equal(t, kind, k)
+		tok, err := dec.Token()
+		require.Nil(t, err, "err should be nil")
+		assert.Equal(t, kind, tok.Kind)
+	}
+	k, err := dec.PeekKind()
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, TokenInvalid, k)
+
+	dec = NewDecoder(strings.NewReader(`[x]`))
+	_, err = dec.Token()
+	require.Nil(t, err, "err should be nil")
+	_, err = dec.PeekKind()
+	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+}
+
+func TestDecoderTokenMixed(t *testing.T) {
+	json := `{"total": 2, "users": [{"name":"Jay","age":30}, {"name":"Tom","age":41}], "next": "abc"}`
+	for _, r := range []io.Reader{strings.NewReader(json), iotest.OneByteReader(strings.NewReader(json))} {
+		dec := NewDecoder(r)
+		var users []testTokenObject
+		var total int
+		var next string
+		tok, err := dec.Token()
+		require.Nil(t, err, "err should be nil")
+		require.Equal(t, TokenObjectStart, tok.Kind)
+		for {
+			tok, err = dec.Token()
+			require.Nil(t, err, "err should be nil")
+			if tok.Kind == TokenObjectEnd {
+				break
+			}
+			require.Equal(t, TokenKey, tok.Kind)
+			switch string(tok.Value) {
+			case "total":
+				require.Nil(t, dec.Int(&total), "err should be nil")
+			case "next":
+				require.Nil(t, dec.String(&next), "err should be nil")
+			case "users":
+				tok, err = dec.Token()
+				require.Nil(t, err, "err should be nil")
+				require.Equal(t, TokenArrayStart, tok.Kind)
+				for {
+					k, err := dec.PeekKind()
+					require.Nil(t, err, "err should be nil")
+					if k == TokenArrayEnd {
+						break
+					}
+					var u testTokenObject
+					require.Nil(t, dec.Object(&u), "err should be nil")
+					users = append(users, u)
+				}
+				tok, err = dec.Token()
+				require.Nil(t, err, "err should be nil")
+				require.Equal(t, TokenArrayEnd, tok.Kind)
+			}
+		}
+		_, err = dec.Token()
+		assert.Equal(t, io.EOF, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, "abc", next)
+		assert.Equal(t, []testTokenObject{{"Jay", 30}, {"Tom", 41}}, users)
+	}
+}
+
+func TestDecoderTokenLimits(t *testing.T) {
+	t.Run("max-depth", func(t *testing.T) {
+		dec := BorrowDecoder(strings.NewReader(`[[[]]]`))
+		defer dec.Release()
+		dec.SetMaxDepth(2)
+		tokens, err := readTokens(dec)
+		assert.IsType(t, &MaxDepthError{}, err, "err should be of type *MaxDepthError")
+		assert.Equal(t, []string{"3:[", "3:["}, tokens)
+	})
+	t.Run("max-depth-restored", func(t *testing.T) {
+		dec := BorrowDecoder(strings.NewReader(`[[]] [[]]`))
+		defer dec.Release()
+		dec.SetMaxDepth(2)
+		_, err := readTokens(dec)
+		assert.Nil(t, err, "err should be nil")
+	})
+	t.Run("max-string-length", func(t *testing.T) {
+		dec := BorrowDecoder(strings.NewReader(`["abc","abcd"]`))
+		defer dec.Release()
+		dec.SetMaxStringLength(3)
+		_, err := readTokens(dec)
+		assert.IsType(t, &LimitError{}, err, "err should be of type *LimitError")
+	})
+	t.Run("max-bytes", func(t *testing.T) {
+		dec := BorrowDecoder(strings.NewReader(`[1,2,3]`))
+		defer dec.Release()
+		dec.SetMaxBytes(4)
+		_, err := readTokens(dec)
+		assert.IsType(t, &LimitError{}, err, "err should be of type *LimitError")
+	})
+}
+
+func TestDecoderTokenPoolError(t *testing.T) {
+	dec := BorrowDecoder(nil)
+	dec.Release()
+	assert.Panics(t, func() { _, _ = dec.Token() }, "Token should panic on a released decoder")
+	assert.Panics(t, func() { _, _ = dec.PeekKind() }, "PeekKind should panic on a released decoder")
+}
+
+// testKindObject decodes key "v" according to its kind.
+type testKindObject struct {
+	kind TokenKind
+	str  string
+	num  float64
+	obj  *testKindObject
+	arr  testSliceInts
+	b    bool
+}
+
+func (t *testKindObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	if k != "v" {
+		return nil
+	}
+	var err error
+	t.kind, err = dec.PeekKind()
+	if err != nil {
+		return err
+	}
+	switch t.kind {
+	case TokenString:
+		return dec.String(&t.str)
+	case TokenNumber:
+		return dec.Float64(&t.num)
+	case TokenObjectStart:
+		t.obj = &testKindObject{}
+		return dec.Object(t.obj)
+	case TokenArrayStart:
+		return dec.Array(&t.arr)
+	case TokenBool:
+		return dec.Bool(&t.b)
+	}
+	return nil
+}
+
+func (t *testKindObject) NKeys() int {
+	return 0
+}
+
+func TestDecoderPeekKindValue(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expected testKindObject
+	}{
+		{
+			name:     "string",
+			json:     `{"v" : "abc"}`,
+			expected: testKindObject{kind: TokenString, str: "abc"},
+		},
+		{
+			name:     "number",
+			json:     `{"v":-1.5}`,
+			expected: testKindObject{kind: TokenNumber, num: -1.5},
+		},
+		{
+			name:     "object",
+			json:     `{"v":  {"v":"a"}}`,
+			expected: testKindObject{kind: TokenObjectStart, obj: &testKindObject{kind: TokenString, str: "a"}},
+		},
+		{
+			name:     "array",
+			json:     `{"v":[1,2]}`,
+			expected: testKindObject{kind: TokenArrayStart, arr: testSliceInts{1, 2}},
+		},
+		{
+			name:     "bool",
+			json:     `{"v":true}`,
+			expected: testKindObject{kind: TokenBool, b: true},
+		},
+		{
+			name:     "null",
+			json:     `{"v":null}`,
+			expected: testKindObject{kind: TokenNull},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := testKindObject{}
+			err := UnmarshalJSONObject([]byte(testCase.json), &v)
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, v)
+
+			v = testKindObject{}
+			dec := NewDecoder(iotest.OneByteReader(strings.NewReader(testCase.json)))
+			err = dec.DecodeObject(&v)
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, v)
+		})
+	}
+}
+
+func TestDecoderPeekKindStream(t *testing.T) {
+	dec := NewDecoder(io.MultiReader(strings.NewReader(`"a" `), strings.NewReader(`{"v":1}`)))
+	k, err := dec.PeekKind()
+	require.Nil(t, err, "err should be nil")
+	require.Equal(t, TokenString, k)
+	var s string
+	require.Nil(t, dec.Decode(&s), "err should be nil")
+	k, err = dec.PeekKind()
+	require.Nil(t, err, "err should be nil")
+	require.Equal(t, TokenObjectStart, k)
+	v := testKindObject{}
+	require.Nil(t, dec.Decode(&v), "err should be nil")
+	assert.Equal(t, testKindObject{kind: TokenNumber, num: 1}, v)
+}
+
+func TestTokenKindString(t *testing.T) {
+	assert.Equal(t, "object start", TokenObjectStart.String())
+	assert.Equal(t, "null", TokenNull.String())
+	assert.Equal(t, "invalid", TokenInvalid.String())
+	assert.Equal(t, "invalid", TokenKind(42).String())
+}
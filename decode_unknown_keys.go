@@ -0,0 +1,20 @@
+package gojay
+
+// UnknownKeys holds the keys of a JSON object which are not decoded, with their raw value.
+//
+// An UnmarshalerJSONObject can keep them in a field and implement UnknownKeysCollector by calling
+// its CollectUnknownKey method, then write them back with Encoder.UnknownKeys,
+// so that a document keeps the keys its Go type does not know when decoded and encoded again:
+//
+//	func (u *user) CollectUnknownKey(k string, v gojay.EmbeddedJSON) {
+//		u.unknownKeys.CollectUnknownKey(k, v)
+//	}
+type UnknownKeys map[string]EmbeddedJSON
+
+// CollectUnknownKey implements UnknownKeysCollector, allocating the map if needed.
+func (u *UnknownKeys) CollectUnknownKey(key string, value EmbeddedJSON) {
+	if *u == nil {
+		*u = make(UnknownKeys)
+	}
+	(*u)[key] = value
+}
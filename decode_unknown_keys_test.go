@@ -0,0 +1,118 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testUnknownKeysObject decodes key "name" and collects the other ones.
+type testUnknownKeysObject struct {
+	name  string
+	child *testUnknownKeysObject
+	extra UnknownKeys
+}
+
+func (t *testUnknownKeysObject) UnmarshalJSONObject(dec *Decoder, k string) error {
+	switch k {
+	case "name":
+		return dec.String(&t.name)
+	case "child":
+		return dec.ObjectNull(&t.child)
+	}
+	return nil
+}
+
+func (t *testUnknownKeysObject) NKeys() int {
+	return 2
+}
+
+func (t *testUnknownKeysObject) CollectUnknownKey(k string, v EmbeddedJSON) {
+	t.extra.CollectUnknownKey(k, v)
+}
+
+func (t *testUnknownKeysObject) MarshalJSONObject(enc *Encoder) {
+	enc.StringKey("name", t.name)
+	enc.ObjectKeyOmitEmpty("child", t.child)
+	enc.UnknownKeys(t.extra)
+}
+
+func (t *testUnknownKeysObject) IsNil() bool {
+	return t == nil
+}
+
+func TestDecoderUnknownKeys(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expected *testUnknownKeysObject
+	}{
+		{
+			name:     "no-unknown-keys",
+			json:     `{"name":"a"}`,
+			expected: &testUnknownKeysObject{name: "a"},
+		},
+		{
+			name: "unknown-keys",
+			json: `{"id": 1, "name":"a", "tags": ["x", {"y": "}"}], "k\"ey": null}`,
+			expected: &testUnknownKeysObject{
+				name: "a",
+				extra: UnknownKeys{
+					"id":    EmbeddedJSON(`1`),
+					"tags":  EmbeddedJSON(`["x", {"y": "}"}]`),
+					"k\"ey": EmbeddedJSON(`null`),
+				},
+			},
+		},
+		{
+			name: "nested",
+			json: `{"name":"a","child":{"name":"b","v":true},"w":"x"}`,
+			expected: &testUnknownKeysObject{
+				name:  "a",
+				child: &testUnknownKeysObject{name: "b", extra: UnknownKeys{"v": EmbeddedJSON(`true`)}},
+				extra: UnknownKeys{"w": EmbeddedJSON(`"x"`)},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v := &testUnknownKeysObject{}
+			err := UnmarshalJSONObject([]byte(testCase.json), v)
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, v)
+
+			v = &testUnknownKeysObject{}
+			dec := BorrowDecoder(iotest.OneByteReader(strings.NewReader(testCase.json)))
+			defer dec.Release()
+			err = dec.DecodeObject(v)
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, v)
+		})
+	}
+}
+
+func TestDecoderUnknownKeysDisallowUnknownFields(t *testing.T) {
+	v := &testUnknownKeysObject{}
+	err := UnmarshalWithOptions([]byte(`{"name":"a","id":1}`), v, DisallowUnknownFields())
+	require.Nil(t, err, "collected keys should not be unknown fields")
+	assert.Equal(t, UnknownKeys{"id": EmbeddedJSON(`1`)}, v.extra)
+}
+
+func TestDecoderUnknownKeysInvalidJSON(t *testing.T) {
+	v := &testUnknownKeysObject{}
+	err := UnmarshalJSONObject([]byte(`{"name":"a","id":x}`), v)
+	assert.IsType(t, InvalidJSONError(""), err, "err should be of type InvalidJSONError")
+}
+
+func TestDecoderUnknownKeysRoundTrip(t *testing.T) {
+	json := `{"name":"a","child":{"name":"b","v":[1,2]},"id":1,"z":{"a":null}}`
+	v := &testUnknownKeysObject{}
+	err := UnmarshalJSONObject([]byte(json), v)
+	require.Nil(t, err, "err should be nil")
+	b, err := MarshalJSONObject(v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, json, string(b))
+}
@@ -1,9 +1,11 @@
 package gojay
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
 )
 
 var nullBytes = []byte("null")
@@ -31,9 +33,9 @@ var nullBytes = []byte("null")
 func MarshalJSONArray(v MarshalerJSONArray) ([]byte, error) {
 	enc := BorrowEncoder(nil)
 	enc.grow(512)
-	enc.writeByte('[')
+	enc.writeOpen('[')
 	v.(MarshalerJSONArray).MarshalJSONArray(enc)
-	enc.writeByte(']')
+	enc.writeClose(']')
 
 	defer func() {
 		enc.buf = make([]byte, 0, 512)
@@ -78,22 +80,22 @@ func MarshalJSONObject(v MarshalerJSONObject) ([]byte, error) {
 // Marshal returns the JSON encoding of v.
 //
 // If v is nil, not an implementation MarshalerJSONObject or MarshalerJSONArray or not one of the following types:
-//	string, int, int8, int16, int32, int64, uint8, uint16, uint32, uint64, float64, float32, bool
+//	string, int, int8, int16, int32, int64, uint8, uint16, uint32, uint64, float64, float32, bool, *big.Int, *big.Float, Number, []byte
 // Marshal returns an InvalidMarshalError.
 func Marshal(v interface{}) ([]byte, error) {
-	return marshal(v, false)
+	return marshal(v, false, "", "")
 }
 
 // MarshalAny returns the JSON encoding of v.
 //
 // If v is nil, not an implementation MarshalerJSONObject or MarshalerJSONArray or not one of the following types:
-//	string, int, int8, int16, int32, int64, uint8, uint16, uint32, uint64, float64, float32, bool
+//	string, int, int8, int16, int32, int64, uint8, uint16, uint32, uint64, float64, float32, bool, *big.Int, *big.Float, Number, []byte
 // MarshalAny falls back to "json/encoding" package to marshal the value.
 func MarshalAny(v interface{}) ([]byte, error) {
-	return marshal(v, true)
+	return marshal(v, true, "", "")
 }
 
-func marshal(v interface{}, any bool) ([]byte, error) {
+func marshal(v interface{}, any bool, prefix, indent string) ([]byte, error) {
 	var (
 		enc = BorrowEncoder(nil)
 
@@ -105,6 +107,7 @@ func marshal(v interface{}, any bool) ([]byte, error) {
 		enc.buf = make([]byte, 0, 512)
 		enc.Release()
 	}()
+	enc.SetIndent(prefix, indent)
 
 	buf, err = func() ([]byte, error) {
 		switch vt := v.(type) {
@@ -140,6 +143,14 @@ func marshal(v interface{}, any bool) ([]byte, error) {
 			return enc.encodeFloat32(vt)
 		case *EmbeddedJSON:
 			return enc.encodeEmbeddedJSON(vt)
+		case *big.Int:
+			return enc.encodeBigInt(vt)
+		case *big.Float:
+			return enc.encodeBigFloat(vt)
+		case Number:
+			return enc.encodeNumber(vt)
+		case []byte:
+			return enc.encodeBytes(vt, base64.StdEncoding)
 		default:
 			if any {
 				return json.Marshal(vt)
@@ -172,6 +183,12 @@ type Encoder struct {
 	err      error
 	hasKeys  bool
 	keys     []string
+
+	indentPrefix string
+	indentValue  string
+	indentDepth  int
+
+	flushThreshold int
 }
 
 // AppendBytes allows a modular usage by appending bytes manually to the current state of the buffer.
@@ -185,6 +202,7 @@ func (enc *Encoder) AppendByte(b byte) {
 }
 
 // Buf returns the Encoder's buffer.
+// When a flush threshold is set, it only holds the data not yet written to the io.Writer.
 func (enc *Encoder) Buf() []byte {
 	return enc.buf
 }
@@ -196,6 +214,31 @@ func (enc *Encoder) Write() (int, error) {
 	return i, err
 }
 
+// SetFlushThreshold makes the Encoder write its buffer to the io.Writer and reuse it
+// as soon as it holds more than n bytes while a value is being encoded,
+// instead of keeping the whole value in memory until it is encoded.
+// An error returned by the io.Writer is returned by the Encode methods.
+// A threshold lower or equal to 0 disables flushing, which is the default.
+//
+// Values are written in several chunks, so a StreamEncoder with several consumers must not use a threshold.
+func (enc *Encoder) SetFlushThreshold(n int) {
+	enc.flushThreshold = n
+}
+
+// flush writes the buffer to the io.Writer, except its last byte,
+// which is kept to know if a comma must precede the next value.
+func (enc *Encoder) flush() {
+	last := len(enc.buf) - 1
+	c := enc.buf[last]
+	enc.buf = enc.buf[:last]
+	if enc.err == nil {
+		if _, err := enc.Write(); err != nil {
+			enc.err = err
+		}
+	}
+	enc.buf = append(enc.buf[:0], c)
+}
+
 func (enc *Encoder) getPreviousRune() byte {
 	last := len(enc.buf) - 1
 	return enc.buf[last]
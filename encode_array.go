@@ -15,9 +15,9 @@ func (enc *Encoder) EncodeArray(v MarshalerJSONArray) error {
 }
 func (enc *Encoder) encodeArray(v MarshalerJSONArray) ([]byte, error) {
 	enc.grow(200)
-	enc.writeByte('[')
+	enc.writeOpen('[')
 	v.MarshalJSONArray(enc)
-	enc.writeByte(']')
+	enc.writeClose(']')
 	return enc.buf, enc.err
 }
 
@@ -66,8 +66,9 @@ func (enc *Encoder) Array(v MarshalerJSONArray) {
 		if r != '[' {
 			enc.writeByte(',')
 		}
-		enc.writeByte('[')
-		enc.writeByte(']')
+		enc.writeIndent()
+		enc.writeOpen('[')
+		enc.writeClose(']')
 		return
 	}
 	enc.grow(100)
@@ -75,9 +76,10 @@ func (enc *Encoder) Array(v MarshalerJSONArray) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.writeByte('[')
+	enc.writeIndent()
+	enc.writeOpen('[')
 	v.MarshalJSONArray(enc)
-	enc.writeByte(']')
+	enc.writeClose(']')
 }
 
 // ArrayOmitEmpty adds an array or slice to be encoded, must be used inside a slice or array encoding (does not encode a key)
@@ -91,9 +93,10 @@ func (enc *Encoder) ArrayOmitEmpty(v MarshalerJSONArray) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.writeByte('[')
+	enc.writeIndent()
+	enc.writeOpen('[')
 	v.MarshalJSONArray(enc)
-	enc.writeByte(']')
+	enc.writeClose(']')
 }
 
 // ArrayNullEmpty adds an array or slice to be encoded, must be used inside a slice or array encoding (does not encode a key)
@@ -104,13 +107,14 @@ func (enc *Encoder) ArrayNullEmpty(v MarshalerJSONArray) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	if v.IsNil() {
 		enc.writeBytes(nullBytes)
 		return
 	}
-	enc.writeByte('[')
+	enc.writeOpen('[')
 	v.MarshalJSONArray(enc)
-	enc.writeByte(']')
+	enc.writeClose(']')
 }
 
 // ArrayKey adds an array or slice to be encoded, must be used inside an object as it will encode a key
@@ -127,10 +131,11 @@ func (enc *Encoder) ArrayKey(key string, v MarshalerJSONArray) {
 		if r != '{' {
 			enc.writeByte(',')
 		}
+		enc.writeIndent()
 		enc.writeByte('"')
 		enc.writeStringEscape(key)
-		enc.writeBytes(objKeyArr)
-		enc.writeByte(']')
+		enc.writeKeyEnd(objKeyArr)
+		enc.writeClose(']')
 		return
 	}
 	enc.grow(5 + len(key))
@@ -138,11 +143,12 @@ func (enc *Encoder) ArrayKey(key string, v MarshalerJSONArray) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKeyArr)
+	enc.writeKeyEnd(objKeyArr)
 	v.MarshalJSONArray(enc)
-	enc.writeByte(']')
+	enc.writeClose(']')
 }
 
 // ArrayKeyOmitEmpty adds an array or slice to be encoded and skips if it is nil.
@@ -161,11 +167,12 @@ func (enc *Encoder) ArrayKeyOmitEmpty(key string, v MarshalerJSONArray) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKeyArr)
+	enc.writeKeyEnd(objKeyArr)
 	v.MarshalJSONArray(enc)
-	enc.writeByte(']')
+	enc.writeClose(']')
 }
 
 // ArrayKeyNullEmpty adds an array or slice to be encoded and encodes `null`` if it is nil.
@@ -181,15 +188,16 @@ func (enc *Encoder) ArrayKeyNullEmpty(key string, v MarshalerJSONArray) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	if v.IsNil() {
 		enc.writeBytes(nullBytes)
 		return
 	}
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKeyArr)
+	enc.writeKeyEnd(objKeyArr)
 	v.MarshalJSONArray(enc)
-	enc.writeByte(']')
+	enc.writeClose(']')
 }
 
 // EncodeArrayFunc is a custom func type implementing MarshaleArray.
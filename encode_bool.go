@@ -66,6 +66,7 @@ func (enc *Encoder) Bool(v bool) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	if v {
 		enc.writeString("true")
 	} else {
@@ -83,6 +84,7 @@ func (enc *Encoder) BoolOmitEmpty(v bool) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeString("true")
 }
 
@@ -93,6 +95,7 @@ func (enc *Encoder) BoolNullEmpty(v bool) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	if v == false {
 		enc.writeBytes(nullBytes)
 		return
@@ -112,9 +115,10 @@ func (enc *Encoder) BoolKey(key string, value bool) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	enc.buf = strconv.AppendBool(enc.buf, value)
 }
 
@@ -134,9 +138,10 @@ func (enc *Encoder) BoolKeyOmitEmpty(key string, v bool) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	enc.buf = strconv.AppendBool(enc.buf, v)
 }
 
@@ -153,9 +158,10 @@ func (enc *Encoder) BoolKeyNullEmpty(key string, v bool) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	if v == false {
 		enc.writeBytes(nullBytes)
 		return
@@ -5,7 +5,11 @@ const hex = "0123456789abcdef"
 // grow grows b's capacity, if necessary, to guarantee space for
 // another n bytes. After grow(n), at least n bytes can be written to b
 // without another allocation. If n is negative, grow panics.
+// If the buffer is over the flush threshold, it is written to the io.Writer first.
 func (enc *Encoder) grow(n int) {
+	if enc.flushThreshold > 0 && len(enc.buf) > enc.flushThreshold && enc.w != nil {
+		enc.flush()
+	}
 	if cap(enc.buf)-len(enc.buf) < n {
 		Buf := make([]byte, len(enc.buf), 2*cap(enc.buf)+n)
 		copy(Buf, enc.buf)
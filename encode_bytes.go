@@ -0,0 +1,180 @@
+package gojay
+
+import (
+	"encoding/base64"
+)
+
+// EncodeBytes encodes a []byte to JSON as a base64 string using base64.StdEncoding.
+// A nil slice is encoded as null.
+func (enc *Encoder) EncodeBytes(v []byte) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	_, _ = enc.encodeBytes(v, base64.StdEncoding)
+	_, err := enc.Write()
+	if err != nil {
+		enc.err = err
+		return err
+	}
+	return nil
+}
+
+// encodeBytes encodes a []byte to JSON as a base64 string
+func (enc *Encoder) encodeBytes(v []byte, encoding *base64.Encoding) ([]byte, error) {
+	enc.appendBytes(v, encoding)
+	return enc.buf, nil
+}
+
+// appendBytes writes v to the buffer as a base64 string, without an intermediate string.
+// A nil slice is written as null.
+func (enc *Encoder) appendBytes(v []byte, encoding *base64.Encoding) {
+	if v == nil {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	n := encoding.EncodedLen(len(v))
+	enc.grow(n + 2)
+	enc.writeByte('"')
+	l := len(enc.buf)
+	enc.buf = enc.buf[:l+n]
+	encoding.Encode(enc.buf[l:], v)
+	enc.writeByte('"')
+}
+
+// AddBytes adds a []byte to be encoded as a base64 string using base64.StdEncoding,
+// must be used inside a slice or array encoding (does not encode a key).
+// A nil slice is encoded as null.
+func (enc *Encoder) AddBytes(v []byte) {
+	enc.Bytes(v)
+}
+
+// AddBytesOmitEmpty adds a []byte to be encoded as a base64 string using base64.StdEncoding and skips it if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBytesOmitEmpty(v []byte) {
+	enc.BytesOmitEmpty(v)
+}
+
+// AddBytesNullEmpty adds a []byte to be encoded as a base64 string using base64.StdEncoding and encodes null if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBytesNullEmpty(v []byte) {
+	enc.BytesNullEmpty(v)
+}
+
+// AddBytesEncoding adds a []byte to be encoded as a base64 string using the given encoding,
+// must be used inside a slice or array encoding (does not encode a key).
+// A nil slice is encoded as null.
+func (enc *Encoder) AddBytesEncoding(v []byte, encoding *base64.Encoding) {
+	enc.BytesEncoding(v, encoding)
+}
+
+// Bytes adds a []byte to be encoded as a base64 string using base64.StdEncoding,
+// must be used inside a slice or array encoding (does not encode a key).
+// A nil slice is encoded as null.
+func (enc *Encoder) Bytes(v []byte) {
+	enc.BytesEncoding(v, base64.StdEncoding)
+}
+
+// BytesOmitEmpty adds a []byte to be encoded as a base64 string using base64.StdEncoding and skips it if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BytesOmitEmpty(v []byte) {
+	if len(v) == 0 {
+		return
+	}
+	enc.BytesEncoding(v, base64.StdEncoding)
+}
+
+// BytesNullEmpty adds a []byte to be encoded as a base64 string using base64.StdEncoding and encodes null if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BytesNullEmpty(v []byte) {
+	if len(v) == 0 {
+		v = nil
+	}
+	enc.BytesEncoding(v, base64.StdEncoding)
+}
+
+// BytesEncoding adds a []byte to be encoded as a base64 string using the given encoding,
+// for example base64.URLEncoding or base64.RawStdEncoding.
+// Must be used inside a slice or array encoding (does not encode a key).
+// A nil slice is encoded as null.
+func (enc *Encoder) BytesEncoding(v []byte, encoding *base64.Encoding) {
+	enc.grow(5)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.appendBytes(v, encoding)
+}
+
+// AddBytesKey adds a []byte to be encoded as a base64 string using base64.StdEncoding,
+// must be used inside an object as it will encode a key.
+// A nil slice is encoded as null.
+func (enc *Encoder) AddBytesKey(key string, v []byte) {
+	enc.BytesKey(key, v)
+}
+
+// AddBytesKeyOmitEmpty adds a []byte to be encoded as a base64 string using base64.StdEncoding and skips it if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBytesKeyOmitEmpty(key string, v []byte) {
+	enc.BytesKeyOmitEmpty(key, v)
+}
+
+// AddBytesKeyNullEmpty adds a []byte to be encoded as a base64 string using base64.StdEncoding and encodes null if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBytesKeyNullEmpty(key string, v []byte) {
+	enc.BytesKeyNullEmpty(key, v)
+}
+
+// AddBytesKeyEncoding adds a []byte to be encoded as a base64 string using the given encoding,
+// must be used inside an object as it will encode a key.
+// A nil slice is encoded as null.
+func (enc *Encoder) AddBytesKeyEncoding(key string, v []byte, encoding *base64.Encoding) {
+	enc.BytesKeyEncoding(key, v, encoding)
+}
+
+// BytesKey adds a []byte to be encoded as a base64 string using base64.StdEncoding,
+// must be used inside an object as it will encode a key.
+// A nil slice is encoded as null.
+func (enc *Encoder) BytesKey(key string, v []byte) {
+	enc.BytesKeyEncoding(key, v, base64.StdEncoding)
+}
+
+// BytesKeyOmitEmpty adds a []byte to be encoded as a base64 string using base64.StdEncoding and skips it if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BytesKeyOmitEmpty(key string, v []byte) {
+	if len(v) == 0 {
+		return
+	}
+	enc.BytesKeyEncoding(key, v, base64.StdEncoding)
+}
+
+// BytesKeyNullEmpty adds a []byte to be encoded as a base64 string using base64.StdEncoding and encodes null if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BytesKeyNullEmpty(key string, v []byte) {
+	if len(v) == 0 {
+		v = nil
+	}
+	enc.BytesKeyEncoding(key, v, base64.StdEncoding)
+}
+
+// BytesKeyEncoding adds a []byte to be encoded as a base64 string using the given encoding,
+// for example base64.URLEncoding or base64.RawStdEncoding.
+// Must be used inside an object as it will encode a key.
+// A nil slice is encoded as null.
+func (enc *Encoder) BytesKeyEncoding(key string, v []byte, encoding *base64.Encoding) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(len(key) + 8)
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.appendBytes(v, encoding)
+}
@@ -0,0 +1,115 @@
+package gojay
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoderBytes(t *testing.T) {
+	testCases := []struct {
+		name         string
+		encode       func(enc *Encoder)
+		expectedJSON string
+	}{
+		{
+			name: "key",
+			encode: func(enc *Encoder) {
+				enc.BytesKey("a", []byte("hello world"))
+				enc.AddBytesKey("b", []byte{})
+				enc.BytesKey("c", nil)
+			},
+			expectedJSON: `{"a":"aGVsbG8gd29ybGQ=","b":"","c":null}`,
+		},
+		{
+			name: "key-omit-empty",
+			encode: func(enc *Encoder) {
+				enc.BytesKeyOmitEmpty("a", nil)
+				enc.AddBytesKeyOmitEmpty("b", []byte{})
+				enc.BytesKeyOmitEmpty("c", []byte{1})
+			},
+			expectedJSON: `{"c":"AQ=="}`,
+		},
+		{
+			name: "key-null-empty",
+			encode: func(enc *Encoder) {
+				enc.BytesKeyNullEmpty("a", []byte{})
+				enc.AddBytesKeyNullEmpty("b", []byte{1})
+			},
+			expectedJSON: `{"a":null,"b":"AQ=="}`,
+		},
+		{
+			name: "key-encoding",
+			encode: func(enc *Encoder) {
+				enc.BytesKeyEncoding("std", []byte{0xfb, 0xff}, base64.StdEncoding)
+				enc.BytesKeyEncoding("url", []byte{0xfb, 0xff}, base64.URLEncoding)
+				enc.AddBytesKeyEncoding("raw", []byte{0xfb, 0xff}, base64.RawStdEncoding)
+				enc.BytesKeyEncoding("rawURL", []byte{0xfb, 0xff}, base64.RawURLEncoding)
+			},
+			expectedJSON: `{"std":"+/8=","url":"-_8=","raw":"+/8","rawURL":"-_8"}`,
+		},
+		{
+			name: "interface",
+			encode: func(enc *Encoder) {
+				enc.AddInterfaceKey("a", []byte("gojay"))
+			},
+			expectedJSON: `{"a":"Z29qYXk="}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			builder := &strings.Builder{}
+			enc := BorrowEncoder(builder)
+			defer enc.Release()
+			err := enc.EncodeObject(EncodeObjectFunc(testCase.encode))
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expectedJSON, builder.String())
+		})
+	}
+}
+
+func TestEncoderBytesArray(t *testing.T) {
+	builder := &strings.Builder{}
+	enc := BorrowEncoder(builder)
+	defer enc.Release()
+	err := enc.EncodeArray(EncodeArrayFunc(func(enc *Encoder) {
+		enc.Bytes([]byte{1})
+		enc.AddBytes(nil)
+		enc.BytesOmitEmpty([]byte{})
+		enc.AddBytesOmitEmpty([]byte{2})
+		enc.BytesNullEmpty([]byte{})
+		enc.AddBytesNullEmpty([]byte{3})
+		enc.BytesEncoding([]byte{0xfb, 0xff}, base64.URLEncoding)
+		enc.AddBytesEncoding([]byte{0xfb, 0xff}, base64.RawURLEncoding)
+		enc.AddInterface([]byte{4})
+	}))
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, `["AQ==",null,"Ag==",null,"Aw==","-_8=","-_8","BA=="]`, builder.String())
+}
+
+func TestEncoderBytesMarshal(t *testing.T) {
+	v := []byte("the quick brown fox jumps over the lazy dog\x00\xff")
+	b, err := Marshal(v)
+	require.Nil(t, err, "err should be nil")
+	expected, err := json.Marshal(v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, string(expected), string(b))
+
+	var decoded []byte
+	err = Unmarshal(b, &decoded)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, v, decoded)
+
+	builder := &strings.Builder{}
+	enc := BorrowEncoder(builder)
+	defer enc.Release()
+	err = enc.Encode([]byte("a"))
+	require.Nil(t, err, "err should be nil")
+	err = enc.EncodeBytes(nil)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, `"YQ=="null`, builder.String())
+}
@@ -0,0 +1,121 @@
+package gojay
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testChunkWriter struct {
+	bytes.Buffer
+	chunks  int
+	maxSize int
+}
+
+func (w *testChunkWriter) Write(b []byte) (int, error) {
+	w.chunks++
+	if len(b) > w.maxSize {
+		w.maxSize = len(b)
+	}
+	return w.Buffer.Write(b)
+}
+
+type testFlushObjects int
+
+func (t testFlushObjects) MarshalJSONArray(enc *Encoder) {
+	for i := 0; i < int(t); i++ {
+		enc.AddObject(EncodeObjectFunc(func(enc *Encoder) {
+			enc.IntKey("id", i)
+			enc.StringKey("name", "some name")
+			enc.ArrayKey("tags", EncodeArrayFunc(func(enc *Encoder) {
+				enc.AddString("a")
+				enc.AddString("b")
+			}))
+		}))
+	}
+}
+
+func (t testFlushObjects) IsNil() bool {
+	return false
+}
+
+func TestEncoderFlushThreshold(t *testing.T) {
+	testCases := []struct {
+		name      string
+		threshold int
+		indent    string
+	}{
+		{
+			name:      "disabled",
+			threshold: 0,
+		},
+		{
+			name:      "threshold",
+			threshold: 256,
+		},
+		{
+			name:      "threshold-1",
+			threshold: 1,
+		},
+		{
+			name:      "threshold-indent",
+			threshold: 256,
+			indent:    "  ",
+		},
+	}
+	v := testFlushObjects(1000)
+	expected, err := MarshalJSONArray(v)
+	require.Nil(t, err, "err should be nil")
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &testChunkWriter{}
+			enc := BorrowEncoder(w)
+			defer enc.Release()
+			enc.SetFlushThreshold(testCase.threshold)
+			enc.SetIndent("", testCase.indent)
+			err := enc.EncodeArray(v)
+			require.Nil(t, err, "err should be nil")
+			if testCase.indent != "" {
+				indented := &bytes.Buffer{}
+				require.Nil(t, json.Indent(indented, expected, "", testCase.indent))
+				assert.Equal(t, indented.String(), w.String(), "result should be equal to indented result")
+			} else {
+				assert.Equal(t, string(expected), w.String(), "result should be equal to Marshal result")
+			}
+			if testCase.threshold == 0 {
+				assert.Equal(t, 1, w.chunks, "result should be written at once")
+				return
+			}
+			assert.True(t, w.chunks > 1, "result should be written in several chunks")
+			if testCase.indent == "" {
+				// a chunk holds at most one object over the threshold
+				assert.True(t, w.maxSize < testCase.threshold+64, "chunks should not be much larger than the threshold")
+			}
+		})
+	}
+}
+
+func TestEncoderFlushThresholdError(t *testing.T) {
+	enc := BorrowEncoder(TestWriterError(""))
+	defer enc.Release()
+	enc.SetFlushThreshold(256)
+	err := enc.EncodeArray(testFlushObjects(100))
+	assert.NotNil(t, err, "err should not be nil")
+	assert.Equal(t, "Test Error", err.Error(), "err should be the writer error")
+	assert.True(t, len(enc.Buf()) < 512, "buffer should not grow after an error")
+}
+
+func TestEncoderFlushThresholdReset(t *testing.T) {
+	w := &testChunkWriter{}
+	enc := BorrowEncoder(w)
+	enc.SetFlushThreshold(1)
+	enc.Release()
+	enc = BorrowEncoder(w)
+	defer enc.Release()
+	err := enc.EncodeArray(testFlushObjects(10))
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, 1, w.chunks, "a borrowed encoder should not flush")
+}
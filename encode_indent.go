@@ -0,0 +1,165 @@
+package gojay
+
+// MarshalIndent is like Marshal but applies indentation to format the output.
+// Each JSON element in the output begins on a new line beginning with prefix
+// followed by one or more copies of indent according to the indentation nesting.
+func MarshalIndent(v interface{}, prefix, indent string) ([]byte, error) {
+	return marshal(v, false, prefix, indent)
+}
+
+// SetIndent instructs the Encoder to format each value it writes to its io.Writer
+// as if indented by the package-level function MarshalIndent.
+// Calling SetIndent("", "") disables indentation.
+func (enc *Encoder) SetIndent(prefix, indent string) {
+	enc.indentPrefix = prefix
+	enc.indentValue = indent
+	enc.indentDepth = 0
+}
+
+func (enc *Encoder) isIndented() bool {
+	return enc.indentPrefix != "" || enc.indentValue != ""
+}
+
+// writeIndent starts a new line for the next element of an object or an array.
+func (enc *Encoder) writeIndent() {
+	if enc.indentDepth > 0 {
+		enc.writeNewLine()
+	}
+}
+
+func (enc *Encoder) writeNewLine() {
+	enc.writeByte('\n')
+	enc.writeString(enc.indentPrefix)
+	for i := 0; i < enc.indentDepth; i++ {
+		enc.writeString(enc.indentValue)
+	}
+}
+
+// writeOpen writes the start of an object or an array.
+func (enc *Encoder) writeOpen(c byte) {
+	enc.writeByte(c)
+	if enc.isIndented() {
+		enc.indentDepth++
+	}
+}
+
+// writeClose writes the end of an object or an array, on a new line unless it is empty.
+func (enc *Encoder) writeClose(c byte) {
+	if enc.indentDepth > 0 {
+		enc.indentDepth--
+		if r := enc.getPreviousRune(); r != '{' && r != '[' {
+			enc.writeNewLine()
+		}
+	}
+	enc.writeByte(c)
+}
+
+// writeKeyEnd writes the end of a key, one of objKey, objKeyStr, objKeyObj or objKeyArr,
+// with a space after the colon when indenting.
+func (enc *Encoder) writeKeyEnd(b []byte) {
+	if !enc.isIndented() {
+		enc.writeBytes(b)
+		return
+	}
+	enc.writeBytes(objKey)
+	enc.writeByte(' ')
+	if len(b) > 2 {
+		if b[2] == '"' {
+			enc.writeByte('"')
+		} else {
+			enc.writeOpen(b[2])
+		}
+	}
+}
+
+// writeEmbeddedJSON writes v, indented at the current depth when indenting.
+func (enc *Encoder) writeEmbeddedJSON(v EmbeddedJSON) {
+	if !enc.isIndented() {
+		enc.writeBytes(v)
+		return
+	}
+	s := indentState{depth: enc.indentDepth}
+	enc.buf = s.indent(enc.buf, v, enc.indentPrefix, enc.indentValue)
+}
+
+// indentState holds the state of the indentation of a raw JSON value.
+type indentState struct {
+	depth    int
+	inString bool
+	escaped  bool
+	// a container was just opened, it stays on a single line if it is empty
+	opened bool
+}
+
+// indent appends to dst the indented form of the compact JSON src.
+// Values outside of objects and arrays, like the delimiters of a stream, are copied as is.
+func (s *indentState) indent(dst, src []byte, prefix, indent string) []byte {
+	for _, c := range src {
+		if s.inString {
+			dst = append(dst, c)
+			if s.escaped {
+				s.escaped = false
+			} else if c == '\\' {
+				s.escaped = true
+			} else if c == '"' {
+				s.inString = false
+			}
+			continue
+		}
+		if s.depth == 0 {
+			dst = append(dst, c)
+			switch c {
+			case '"':
+				s.inString = true
+			case '{', '[':
+				s.depth++
+				s.opened = true
+			}
+			continue
+		}
+		switch c {
+		case ' ', '\t', '\n', '\r':
+			// insignificant white spaces, from embedded JSON for example
+			continue
+		}
+		if s.opened {
+			s.opened = false
+			if c == '}' || c == ']' {
+				s.depth--
+				dst = append(dst, c)
+				continue
+			}
+			dst = s.newLine(dst, prefix, indent)
+		}
+		switch c {
+		case '"':
+			s.inString = true
+			dst = append(dst, c)
+		case '{', '[':
+			s.depth++
+			s.opened = true
+			dst = append(dst, c)
+		case '}', ']':
+			s.depth--
+			dst = s.newLine(dst, prefix, indent)
+			dst = append(dst, c)
+		case ',':
+			dst = append(dst, c)
+			dst = s.newLine(dst, prefix, indent)
+		case ':':
+			dst = append(dst, c, ' ')
+		default:
+			dst = append(dst, c)
+		}
+	}
+	return dst
+}
+
+func (s *indentState) newLine(dst []byte, prefix, indent string) []byte {
+	dst = append(dst, '\n')
+	dst = append(dst, prefix...)
+	for i := 0; i < s.depth; i++ {
+		dst = append(dst, indent...)
+	}
+	return dst
+}
@@ -0,0 +1,168 @@
+package gojay
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoderIndent(t *testing.T) {
+	testCases := []struct {
+		name   string
+		prefix string
+		indent string
+		encode func(enc *Encoder) error
+	}{
+		{
+			name:   "object",
+			indent: "  ",
+			encode: func(enc *Encoder) error {
+				return enc.Encode(&testObject{testStr: "a \"quoted\" {string}, [with] delimiters:", testInt: 1})
+			},
+		},
+		{
+			name:   "nested",
+			indent: "\t",
+			encode: func(enc *Encoder) error {
+				return enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+					enc.StringKey("str", "a")
+					enc.IntKey("int", 1)
+					enc.ObjectKey("obj", EncodeObjectFunc(func(enc *Encoder) {
+						enc.BoolKey("bool", true)
+						enc.AddNullKey("null")
+					}))
+					enc.ArrayKey("arr", EncodeArrayFunc(func(enc *Encoder) {
+						enc.AddInt(1)
+						enc.AddObject(EncodeObjectFunc(func(enc *Encoder) {
+							enc.FloatKey("float", 1.5)
+						}))
+						enc.AddArray(EncodeArrayFunc(func(enc *Encoder) {
+							enc.String("b")
+						}))
+					}))
+				}))
+			},
+		},
+		{
+			name:   "empty",
+			prefix: "//",
+			indent: "  ",
+			encode: func(enc *Encoder) error {
+				return enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+					enc.ObjectKey("obj", EncodeObjectFunc(func(enc *Encoder) {}))
+					enc.ArrayKey("arr", EncodeArrayFunc(func(enc *Encoder) {}))
+				}))
+			},
+		},
+		{
+			name:   "embedded-json",
+			indent: "  ",
+			encode: func(enc *Encoder) error {
+				return enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+					ej := EmbeddedJSON("{ \"a\" : [ 1,\n 2 ], \"b\": { } }")
+					enc.AddEmbeddedJSONKey("embedded", &ej)
+				}))
+			},
+		},
+		{
+			name:   "object-keys",
+			indent: "  ",
+			encode: func(enc *Encoder) error {
+				return enc.EncodeObjectKeys(&testObject{testStr: "a", testInt: 1}, []string{"testStr", "testInt"})
+			},
+		},
+		{
+			name:   "array",
+			prefix: "> ",
+			indent: "    ",
+			encode: func(enc *Encoder) error {
+				return enc.EncodeArray(&TestEncodingArrStrings{"a", "b"})
+			},
+		},
+		{
+			name:   "string",
+			indent: "  ",
+			encode: func(enc *Encoder) error {
+				return enc.EncodeString("{[a]}")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			compact := &bytes.Buffer{}
+			enc := BorrowEncoder(compact)
+			err := testCase.encode(enc)
+			enc.Release()
+			require.Nil(t, err, "err should be nil")
+
+			expected := &bytes.Buffer{}
+			err = json.Indent(expected, compact.Bytes(), testCase.prefix, testCase.indent)
+			require.Nil(t, err, "compact output should be valid JSON")
+
+			indented := &bytes.Buffer{}
+			enc = BorrowEncoder(indented)
+			enc.SetIndent(testCase.prefix, testCase.indent)
+			err = testCase.encode(enc)
+			enc.Release()
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, expected.String(), indented.String(), "result should be equal to json.Indent result")
+		})
+	}
+}
+
+func TestEncoderIndentReset(t *testing.T) {
+	builder := &strings.Builder{}
+	enc := BorrowEncoder(builder)
+	enc.SetIndent("", "  ")
+	err := enc.EncodeArray(&TestEncodingArrStrings{"a"})
+	assert.Nil(t, err, "err should be nil")
+	enc.SetIndent("", "")
+	err = enc.EncodeArray(&TestEncodingArrStrings{"b"})
+	assert.Nil(t, err, "err should be nil")
+	enc.Release()
+	assert.Equal(t, "[\n  \"a\"\n][\"b\"]", builder.String())
+
+	// a borrowed encoder does not keep the indentation
+	builder.Reset()
+	enc = BorrowEncoder(builder)
+	defer enc.Release()
+	err = enc.EncodeArray(&TestEncodingArrStrings{"c"})
+	assert.Nil(t, err, "err should be nil")
+	assert.Equal(t, `["c"]`, builder.String())
+}
+
+func TestMarshalIndent(t *testing.T) {
+	v := &testObject{testStr: "a", testInt: 1, testBool: true}
+	b, err := MarshalIndent(v, "", "  ")
+	require.Nil(t, err, "err should be nil")
+	compact, err := Marshal(v)
+	require.Nil(t, err, "err should be nil")
+	expected := &bytes.Buffer{}
+	require.Nil(t, json.Indent(expected, compact, "", "  "))
+	assert.Equal(t, expected.String(), string(b))
+
+	_, err = MarshalIndent(struct{}{}, "", "  ")
+	assert.NotNil(t, err, "err should not be nil")
+	assert.IsType(t, InvalidMarshalError(""), err, "err should be of type InvalidMarshalError")
+}
+
+func TestEncodeStreamIndent(t *testing.T) {
+	w := &TestWriter{target: 10, mux: &sync.RWMutex{}}
+	enc := Stream.NewEncoder(w).LineDelimited()
+	enc.SetIndent("", "  ")
+	w.enc = enc
+	s := StreamChanSlice(make(chan *TestEncodingArrStrings))
+	go enc.EncodeStream(s)
+	go feedStreamSlices(s, 10)
+	<-enc.Done()
+	assert.Nil(t, enc.Err(), "enc.Err() should be nil")
+	assert.Len(t, w.result, 10, "w.result should be 10")
+	for _, b := range w.result {
+		assert.Equal(t, "[\n  \"test\",\n  \"test2\"\n]\n", string(b), "every byte buffer should be indented")
+	}
+}
@@ -1,7 +1,9 @@
 package gojay
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 )
 
 // Encode encodes a value to JSON.
@@ -43,6 +45,14 @@ func (enc *Encoder) Encode(v interface{}) error {
 		return enc.EncodeFloat32(vt)
 	case *EmbeddedJSON:
 		return enc.EncodeEmbeddedJSON(vt)
+	case *big.Int:
+		return enc.EncodeBigInt(vt)
+	case *big.Float:
+		return enc.EncodeBigFloat(vt)
+	case Number:
+		return enc.EncodeNumber(vt)
+	case []byte:
+		return enc.EncodeBytes(vt)
 	default:
 		return InvalidMarshalError(fmt.Sprintf(invalidMarshalErrorMsg, vt))
 	}
@@ -79,6 +89,20 @@ func (enc *Encoder) AddInterface(value interface{}) {
 		enc.AddFloat(vt)
 	case float32:
 		enc.AddFloat32(vt)
+	case *big.Int:
+		enc.AddBigInt(vt)
+	case *big.Float:
+		enc.AddBigFloat(vt)
+	case Number:
+		enc.AddNumber(vt)
+	case json.Number:
+		enc.AddNumber(Number(vt))
+	case []byte:
+		enc.AddBytes(vt)
+	case map[string]interface{}:
+		enc.MapInterface(vt)
+	case []interface{}:
+		enc.SliceInterface(vt)
 	default:
 		if vt != nil {
 			enc.err = InvalidMarshalError(fmt.Sprintf(invalidMarshalErrorMsg, vt))
@@ -121,6 +145,20 @@ func (enc *Encoder) AddInterfaceKey(key string, value interface{}) {
 		enc.AddFloatKey(key, vt)
 	case float32:
 		enc.AddFloat32Key(key, vt)
+	case *big.Int:
+		enc.AddBigIntKey(key, vt)
+	case *big.Float:
+		enc.AddBigFloatKey(key, vt)
+	case Number:
+		enc.AddNumberKey(key, vt)
+	case json.Number:
+		enc.AddNumberKey(key, Number(vt))
+	case []byte:
+		enc.AddBytesKey(key, vt)
+	case map[string]interface{}:
+		enc.MapInterfaceKey(key, vt)
+	case []interface{}:
+		enc.SliceInterfaceKey(key, vt)
 	default:
 		if vt != nil {
 			enc.err = InvalidMarshalError(fmt.Sprintf(invalidMarshalErrorMsg, vt))
@@ -171,3 +209,21 @@ func (enc *Encoder) AddInterfaceKeyOmitEmpty(key string, v interface{}) {
 		return
 	}
 }
+
+// interfaceOrNull encodes value like AddInterface but encodes a nil value as null
+func (enc *Encoder) interfaceOrNull(value interface{}) {
+	if value == nil {
+		enc.AddNull()
+		return
+	}
+	enc.AddInterface(value)
+}
+
+// interfaceOrNullKey encodes value like AddInterfaceKey but encodes a nil value as null
+func (enc *Encoder) interfaceOrNullKey(key string, value interface{}) {
+	if value == nil {
+		enc.AddNullKey(key)
+		return
+	}
+	enc.AddInterfaceKey(key, value)
+}
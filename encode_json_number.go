@@ -0,0 +1,186 @@
+package gojay
+
+import (
+	"fmt"
+)
+
+// EncodeNumber encodes a Number to JSON
+func (enc *Encoder) EncodeNumber(v Number) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	_, _ = enc.encodeNumber(v)
+	if enc.err != nil {
+		return enc.err
+	}
+	_, err := enc.Write()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// encodeNumber encodes a Number to JSON
+func (enc *Encoder) encodeNumber(v Number) ([]byte, error) {
+	enc.appendNumber(v)
+	return enc.buf, enc.err
+}
+
+// AddNumber adds a Number to be encoded, must be used inside a slice or array encoding (does not encode a key)
+func (enc *Encoder) AddNumber(v Number) {
+	enc.Number(v)
+}
+
+// AddNumberOmitEmpty adds a Number to be encoded and skips it if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddNumberOmitEmpty(v Number) {
+	enc.NumberOmitEmpty(v)
+}
+
+// AddNumberNullEmpty adds a Number to be encoded and encodes null if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddNumberNullEmpty(v Number) {
+	enc.NumberNullEmpty(v)
+}
+
+// Number adds a Number to be encoded, must be used inside a slice or array encoding (does not encode a key).
+// An empty Number is encoded as 0.
+func (enc *Encoder) Number(v Number) {
+	enc.grow(len(v) + 1)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.appendNumber(v)
+}
+
+// NumberOmitEmpty adds a Number to be encoded and skips it if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) NumberOmitEmpty(v Number) {
+	if v == "" {
+		return
+	}
+	enc.grow(len(v) + 1)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.appendNumber(v)
+}
+
+// NumberNullEmpty adds a Number to be encoded and encodes null if it is empty,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) NumberNullEmpty(v Number) {
+	enc.grow(len(v) + 4)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	if v == "" {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.appendNumber(v)
+}
+
+// AddNumberKey adds a Number to be encoded, must be used inside an object as it will encode a key
+func (enc *Encoder) AddNumberKey(key string, v Number) {
+	enc.NumberKey(key, v)
+}
+
+// AddNumberKeyOmitEmpty adds a Number to be encoded and skips it if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddNumberKeyOmitEmpty(key string, v Number) {
+	enc.NumberKeyOmitEmpty(key, v)
+}
+
+// AddNumberKeyNullEmpty adds a Number to be encoded and encodes null if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddNumberKeyNullEmpty(key string, v Number) {
+	enc.NumberKeyNullEmpty(key, v)
+}
+
+// NumberKey adds a Number to be encoded, must be used inside an object as it will encode a key.
+// An empty Number is encoded as 0.
+func (enc *Encoder) NumberKey(key string, v Number) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(len(v) + len(key) + 4)
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.appendNumber(v)
+}
+
+// NumberKeyOmitEmpty adds a Number to be encoded and skips it if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) NumberKeyOmitEmpty(key string, v Number) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	if v == "" {
+		return
+	}
+	enc.grow(len(v) + len(key) + 4)
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.appendNumber(v)
+}
+
+// NumberKeyNullEmpty adds a Number to be encoded and encodes null if it is empty.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) NumberKeyNullEmpty(key string, v Number) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(len(v) + len(key) + 7)
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	if v == "" {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.appendNumber(v)
+}
+
+// appendNumber appends the literal of v to the buffer as is.
+// If v is not a valid JSON number, it records an InvalidMarshalError and encodes null.
+func (enc *Encoder) appendNumber(v Number) {
+	if v == "" {
+		enc.writeByte('0')
+		return
+	}
+	if !isNumberLiteral(v) {
+		enc.err = InvalidMarshalError(fmt.Sprintf("Invalid number literal %q provided to Marshal", string(v)))
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.writeString(string(v))
+}
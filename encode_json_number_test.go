@@ -0,0 +1,110 @@
+package gojay
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoderNumber(t *testing.T) {
+	testCases := []struct {
+		name         string
+		encode       func(enc *Encoder)
+		expectedJSON string
+	}{
+		{
+			name: "key",
+			encode: func(enc *Encoder) {
+				enc.NumberKey("a", "1.10")
+				enc.AddNumberKey("b", "-1e-7")
+				enc.NumberKey("c", "")
+			},
+			expectedJSON: `{"a":1.10,"b":-1e-7,"c":0}`,
+		},
+		{
+			name: "key-omit-empty",
+			encode: func(enc *Encoder) {
+				enc.NumberKeyOmitEmpty("a", "")
+				enc.AddNumberKeyOmitEmpty("b", "0")
+				enc.NumberKeyOmitEmpty("c", "")
+			},
+			expectedJSON: `{"b":0}`,
+		},
+		{
+			name: "key-null-empty",
+			encode: func(enc *Encoder) {
+				enc.NumberKeyNullEmpty("a", "")
+				enc.AddNumberKeyNullEmpty("b", "2.50")
+			},
+			expectedJSON: `{"a":null,"b":2.50}`,
+		},
+		{
+			name: "interface",
+			encode: func(enc *Encoder) {
+				enc.AddInterfaceKey("a", Number("1.0"))
+				enc.AddInterfaceKey("b", json.Number("2.0"))
+			},
+			expectedJSON: `{"a":1.0,"b":2.0}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			builder := &strings.Builder{}
+			enc := BorrowEncoder(builder)
+			defer enc.Release()
+			err := enc.EncodeObject(EncodeObjectFunc(testCase.encode))
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expectedJSON, builder.String())
+		})
+	}
+}
+
+func TestEncoderNumberArray(t *testing.T) {
+	builder := &strings.Builder{}
+	enc := BorrowEncoder(builder)
+	defer enc.Release()
+	err := enc.EncodeArray(EncodeArrayFunc(func(enc *Encoder) {
+		enc.Number("1.10")
+		enc.AddNumber("")
+		enc.NumberOmitEmpty("")
+		enc.AddNumberOmitEmpty("3")
+		enc.NumberNullEmpty("")
+		enc.AddNumberNullEmpty("4.00")
+		enc.AddInterface(Number("5e0"))
+	}))
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, `[1.10,0,3,null,4.00,5e0]`, builder.String())
+}
+
+func TestEncoderNumberInvalid(t *testing.T) {
+	_, err := Marshal(Number("1.1.1"))
+	assert.IsType(t, InvalidMarshalError(""), err, "err should be of type InvalidMarshalError")
+
+	_, err = MarshalJSONObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.NumberKey("a", "NaN")
+	}))
+	assert.IsType(t, InvalidMarshalError(""), err, "err should be of type InvalidMarshalError")
+
+	builder := &strings.Builder{}
+	enc := BorrowEncoder(builder)
+	defer enc.Release()
+	err = enc.EncodeNumber("1e")
+	assert.IsType(t, InvalidMarshalError(""), err, "err should be of type InvalidMarshalError")
+	assert.Equal(t, "", builder.String(), "nothing should be written")
+}
+
+func TestEncoderNumberMarshal(t *testing.T) {
+	b, err := Marshal(Number("100.000"))
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "100.000", string(b))
+
+	builder := &strings.Builder{}
+	enc := BorrowEncoder(builder)
+	defer enc.Release()
+	err = enc.Encode(Number("0.10"))
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "0.10", builder.String())
+}
@@ -0,0 +1,160 @@
+package gojay
+
+import "sort"
+
+// EncodeMap marshals the given map[string]V m as a JSON object,
+// each entry is encoded by calling encodeValue which must encode the value with its key.
+// Must be used inside a slice or array encoding (does not encode a key).
+// Entries are encoded in sorted key order so that the output is deterministic.
+//
+// Example:
+//
+//	gojay.EncodeMap(enc, users, func(enc *gojay.Encoder, k string, v *User) {
+//		enc.ObjectKey(k, v)
+//	})
+func EncodeMap[V any](enc *Encoder, m map[string]V, encodeValue func(enc *Encoder, k string, v V)) {
+	enc.Object(encodeMapFunc(m, encodeValue))
+}
+
+// EncodeMapKey marshals the given map[string]V m as a JSON object with the given key,
+// each entry is encoded by calling encodeValue which must encode the value with its key.
+// Must be used inside an object as it will encode a key.
+func EncodeMapKey[V any](enc *Encoder, key string, m map[string]V, encodeValue func(enc *Encoder, k string, v V)) {
+	enc.ObjectKey(key, encodeMapFunc(m, encodeValue))
+}
+
+func encodeMapFunc[V any](m map[string]V, encodeValue func(enc *Encoder, k string, v V)) EncodeObjectFunc {
+	return func(enc *Encoder) {
+		for _, k := range sortedKeys(m) {
+			encodeValue(enc, k, m[k])
+		}
+	}
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// AddMapString marshals the given map[string]string m
+func (enc *Encoder) AddMapString(m map[string]string) {
+	enc.MapString(m)
+}
+
+// MapString marshals the given map[string]string m
+func (enc *Encoder) MapString(m map[string]string) {
+	EncodeMap(enc, m, (*Encoder).StringKey)
+}
+
+// AddMapStringKey marshals the given map[string]string m
+func (enc *Encoder) AddMapStringKey(k string, m map[string]string) {
+	enc.MapStringKey(k, m)
+}
+
+// MapStringKey marshals the given map[string]string m
+func (enc *Encoder) MapStringKey(k string, m map[string]string) {
+	EncodeMapKey(enc, k, m, (*Encoder).StringKey)
+}
+
+// AddMapInt marshals the given map[string]int m
+func (enc *Encoder) AddMapInt(m map[string]int) {
+	enc.MapInt(m)
+}
+
+// MapInt marshals the given map[string]int m
+func (enc *Encoder) MapInt(m map[string]int) {
+	EncodeMap(enc, m, (*Encoder).IntKey)
+}
+
+// AddMapIntKey marshals the given map[string]int m
+func (enc *Encoder) AddMapIntKey(k string, m map[string]int) {
+	enc.MapIntKey(k, m)
+}
+
+// MapIntKey marshals the given map[string]int m
+func (enc *Encoder) MapIntKey(k string, m map[string]int) {
+	EncodeMapKey(enc, k, m, (*Encoder).IntKey)
+}
+
+// AddMapFloat64 marshals the given map[string]float64 m
+func (enc *Encoder) AddMapFloat64(m map[string]float64) {
+	enc.MapFloat64(m)
+}
+
+// MapFloat64 marshals the given map[string]float64 m
+func (enc *Encoder) MapFloat64(m map[string]float64) {
+	EncodeMap(enc, m, (*Encoder).Float64Key)
+}
+
+// AddMapFloat64Key marshals the given map[string]float64 m
+func (enc *Encoder) AddMapFloat64Key(k string, m map[string]float64) {
+	enc.MapFloat64Key(k, m)
+}
+
+// MapFloat64Key marshals the given map[string]float64 m
+func (enc *Encoder) MapFloat64Key(k string, m map[string]float64) {
+	EncodeMapKey(enc, k, m, (*Encoder).Float64Key)
+}
+
+// AddMapBool marshals the given map[string]bool m
+func (enc *Encoder) AddMapBool(m map[string]bool) {
+	enc.MapBool(m)
+}
+
+// MapBool marshals the given map[string]bool m
+func (enc *Encoder) MapBool(m map[string]bool) {
+	EncodeMap(enc, m, (*Encoder).BoolKey)
+}
+
+// AddMapBoolKey marshals the given map[string]bool m
+func (enc *Encoder) AddMapBoolKey(k string, m map[string]bool) {
+	enc.MapBoolKey(k, m)
+}
+
+// MapBoolKey marshals the given map[string]bool m
+func (enc *Encoder) MapBoolKey(k string, m map[string]bool) {
+	EncodeMapKey(enc, k, m, (*Encoder).BoolKey)
+}
+
+// AddMapInterface marshals the given map[string]interface{} m
+func (enc *Encoder) AddMapInterface(m map[string]interface{}) {
+	enc.MapInterface(m)
+}
+
+// MapInterface marshals the given map[string]interface{} m, nil values are encoded as null
+func (enc *Encoder) MapInterface(m map[string]interface{}) {
+	EncodeMap(enc, m, (*Encoder).interfaceOrNullKey)
+}
+
+// AddMapInterfaceKey marshals the given map[string]interface{} m
+func (enc *Encoder) AddMapInterfaceKey(k string, m map[string]interface{}) {
+	enc.MapInterfaceKey(k, m)
+}
+
+// MapInterfaceKey marshals the given map[string]interface{} m, nil values are encoded as null
+func (enc *Encoder) MapInterfaceKey(k string, m map[string]interface{}) {
+	EncodeMapKey(enc, k, m, (*Encoder).interfaceOrNullKey)
+}
+
+// Map is a map of objects implementing MarshalerJSONObject,
+// it saves declaring a named map type to encode a map[string]T:
+//
+//	enc.ObjectKey("users", gojay.Map[*User](u.byName))
+type Map[T MarshalerJSONObject] map[string]T
+
+// MarshalJSONObject implements MarshalerJSONObject, keys are encoded in sorted order
+func (m Map[T]) MarshalJSONObject(enc *Encoder) {
+	for _, k := range sortedKeys(m) {
+		enc.ObjectKey(k, m[k])
+	}
+}
+
+// IsNil implements MarshalerJSONObject
+func (m Map[T]) IsNil() bool {
+	return m == nil
+}
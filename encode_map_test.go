@@ -0,0 +1,223 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func (m *mapsTestObject) MarshalJSONObject(enc *Encoder) {
+	enc.AddMapStringKey("mapString", m.mapString)
+	enc.AddMapIntKey("mapInt", m.mapInt)
+	enc.AddMapFloat64Key("mapFloat64", m.mapFloat64)
+	enc.AddMapBoolKey("mapBool", m.mapBool)
+	enc.AddMapInterfaceKey("mapInterface", m.mapInterface)
+	EncodeMapKey(enc, "mapObject", m.mapObject, func(enc *Encoder, k string, v *testPathItem) {
+		enc.ObjectKey(k, EncodeObjectFunc(func(enc *Encoder) {
+			enc.StringKey("name", v.name)
+			enc.IntKey("price", v.price)
+		}))
+	})
+}
+
+func (m *mapsTestObject) IsNil() bool {
+	return m == nil
+}
+
+func TestEncodeMaps(t *testing.T) {
+	testCases := []struct {
+		name string
+		json string
+		obj  mapsTestObject
+	}{
+		{
+			name: "basic map string",
+			json: `{
+				"mapString": {"foo":"bar","baz":""},
+				"mapInt": {},
+				"mapFloat64": {},
+				"mapBool": {},
+				"mapInterface": {},
+				"mapObject": {}
+			}`,
+			obj: mapsTestObject{
+				mapString: map[string]string{"foo": "bar", "baz": ""},
+			},
+		},
+		{
+			name: "basic map int",
+			json: `{
+				"mapString": {},
+				"mapInt": {"a":1,"b":-2},
+				"mapFloat64": {},
+				"mapBool": {},
+				"mapInterface": {},
+				"mapObject": {}
+			}`,
+			obj: mapsTestObject{
+				mapInt: map[string]int{"a": 1, "b": -2},
+			},
+		},
+		{
+			name: "basic map float64",
+			json: `{
+				"mapString": {},
+				"mapInt": {},
+				"mapFloat64": {"a":1.3,"b":2},
+				"mapBool": {},
+				"mapInterface": {},
+				"mapObject": {}
+			}`,
+			obj: mapsTestObject{
+				mapFloat64: map[string]float64{"a": 1.3, "b": 2},
+			},
+		},
+		{
+			name: "basic map bool",
+			json: `{
+				"mapString": {},
+				"mapInt": {},
+				"mapFloat64": {},
+				"mapBool": {"a":true,"b":false},
+				"mapInterface": {},
+				"mapObject": {}
+			}`,
+			obj: mapsTestObject{
+				mapBool: map[string]bool{"a": true, "b": false},
+			},
+		},
+		{
+			name: "basic map interface",
+			json: `{
+				"mapString": {},
+				"mapInt": {},
+				"mapFloat64": {},
+				"mapBool": {},
+				"mapInterface": {"a":"b","c":[1,null],"d":{"e":true,"f":null}},
+				"mapObject": {}
+			}`,
+			obj: mapsTestObject{
+				mapInterface: map[string]interface{}{
+					"a": "b",
+					"c": []interface{}{float64(1), nil},
+					"d": map[string]interface{}{"e": true, "f": nil},
+				},
+			},
+		},
+		{
+			name: "map of objects",
+			json: `{
+				"mapString": {},
+				"mapInt": {},
+				"mapFloat64": {},
+				"mapBool": {},
+				"mapInterface": {},
+				"mapObject": {"a":{"name":"foo","price":1}}
+			}`,
+			obj: mapsTestObject{
+				mapObject: map[string]*testPathItem{
+					"a": {name: "foo", price: 1},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				b := strings.Builder{}
+				enc := BorrowEncoder(&b)
+				defer enc.Release()
+				err := enc.Encode(&testCase.obj)
+				require.Nil(t, err, "err should be nil")
+				require.JSONEq(t, testCase.json, b.String())
+			},
+		)
+	}
+}
+
+type testSliceMapString []map[string]string
+
+func (t testSliceMapString) MarshalJSONArray(enc *Encoder) {
+	for _, m := range t {
+		enc.AddMapString(m)
+	}
+}
+
+func (t testSliceMapString) IsNil() bool {
+	return t == nil
+}
+
+func TestEncodeSliceMaps(t *testing.T) {
+	b := strings.Builder{}
+	enc := BorrowEncoder(&b)
+	defer enc.Release()
+	err := enc.Encode(testSliceMapString{{"a": "b"}, {}, {"c": "d"}})
+	require.Nil(t, err, "err should be nil")
+	require.Equal(t, `[{"a":"b"},{},{"c":"d"}]`, b.String())
+}
+
+func TestEncodeMapsRoundTrip(t *testing.T) {
+	v := &mapsTestObject{
+		mapString:    map[string]string{"a": "b", "c": "d"},
+		mapInt:       map[string]int{"a": 1},
+		mapInterface: map[string]interface{}{"a": []interface{}{"b", map[string]interface{}{"c": 1.5}}},
+	}
+	b, err := MarshalJSONObject(v)
+	require.Nil(t, err, "err should be nil")
+	var o mapsTestObject
+	err = UnmarshalJSONObject(b, &o)
+	require.Nil(t, err, "err should be nil")
+	require.Equal(t, v.mapString, o.mapString)
+	require.Equal(t, v.mapInt, o.mapInt)
+	require.Equal(t, v.mapInterface, o.mapInterface)
+	require.Equal(t, map[string]bool{}, o.mapBool)
+}
+
+func TestEncodeMapsSorted(t *testing.T) {
+	m := map[string]int{}
+	for _, k := range []string{"d", "a", "c", "b", "aa", "B", "e", "f", "g", "h"} {
+		m[k] = len(m)
+	}
+	expected := `{"B":5,"a":1,"aa":4,"b":3,"c":2,"d":0,"e":6,"f":7,"g":8,"h":9}`
+	for i := 0; i < 20; i++ {
+		b := strings.Builder{}
+		enc := BorrowEncoder(&b)
+		err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+			enc.MapIntKey("m", m)
+		}))
+		enc.Release()
+		require.Nil(t, err, "err should be nil")
+		require.Equal(t, `{"m":`+expected+`}`, b.String(), "keys should be encoded in sorted order")
+	}
+}
+
+func TestEncodeMapObjects(t *testing.T) {
+	b := strings.Builder{}
+	enc := BorrowEncoder(&b)
+	defer enc.Release()
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.ObjectKey("items", Map[*testPathItem]{"a": {name: "foo", price: 1}, "b": {name: "bar"}})
+		enc.ObjectKey("empty", Map[*testPathItem](nil))
+	}))
+	require.Nil(t, err, "err should be nil")
+	require.Equal(t, `{"items":{"a":{"name":"foo","price":1},"b":{"name":"bar","price":0}},"empty":{}}`, b.String())
+
+	m := Map[*testPathItem]{}
+	for _, k := range []string{"d", "a", "c", "b", "e", "f", "g", "h"} {
+		m[k] = &testPathItem{name: k}
+	}
+	for i := 0; i < 20; i++ {
+		b, err := MarshalJSONObject(m)
+		require.Nil(t, err, "err should be nil")
+		require.Equal(
+			t,
+			`{"a":{"name":"a","price":0},"b":{"name":"b","price":0},"c":{"name":"c","price":0},"d":{"name":"d","price":0},`+
+				`"e":{"name":"e","price":0},"f":{"name":"f","price":0},"g":{"name":"g","price":0},"h":{"name":"h","price":0}}`,
+			string(b),
+			"keys should be encoded in sorted order",
+		)
+	}
+}
@@ -12,6 +12,7 @@ func (enc *Encoder) Null() {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeBytes(nullBytes)
 }
 
@@ -32,8 +33,9 @@ func (enc *Encoder) NullKey(key string) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	enc.writeBytes(nullBytes)
 }
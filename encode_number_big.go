@@ -0,0 +1,454 @@
+package gojay
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// EncodeBigInt encodes a *big.Int to JSON
+func (enc *Encoder) EncodeBigInt(v *big.Int) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	_, _ = enc.encodeBigInt(v)
+	if enc.err != nil {
+		return enc.err
+	}
+	_, err := enc.Write()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// encodeBigInt encodes a *big.Int to JSON
+func (enc *Encoder) encodeBigInt(v *big.Int) ([]byte, error) {
+	enc.appendBigInt(v, false)
+	return enc.buf, enc.err
+}
+
+// AddBigInt adds a *big.Int to be encoded, must be used inside a slice or array encoding (does not encode a key)
+func (enc *Encoder) AddBigInt(v *big.Int) {
+	enc.BigInt(v)
+}
+
+// AddBigIntOmitEmpty adds a *big.Int to be encoded and skips it if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBigIntOmitEmpty(v *big.Int) {
+	enc.BigIntOmitEmpty(v)
+}
+
+// AddBigIntNullEmpty adds a *big.Int to be encoded and encodes null if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBigIntNullEmpty(v *big.Int) {
+	enc.BigIntNullEmpty(v)
+}
+
+// BigInt adds a *big.Int to be encoded, must be used inside a slice or array encoding (does not encode a key)
+func (enc *Encoder) BigInt(v *big.Int) {
+	enc.grow(10)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.appendBigInt(v, false)
+}
+
+// BigIntOmitEmpty adds a *big.Int to be encoded and skips it if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BigIntOmitEmpty(v *big.Int) {
+	if v == nil || v.Sign() == 0 {
+		return
+	}
+	enc.grow(10)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.appendBigInt(v, false)
+}
+
+// BigIntNullEmpty adds a *big.Int to be encoded and encodes null if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BigIntNullEmpty(v *big.Int) {
+	enc.grow(10)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	if v == nil || v.Sign() == 0 {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.appendBigInt(v, false)
+}
+
+// AddBigIntKey adds a *big.Int to be encoded, must be used inside an object as it will encode a key
+func (enc *Encoder) AddBigIntKey(key string, v *big.Int) {
+	enc.BigIntKey(key, v)
+}
+
+// AddBigIntKeyOmitEmpty adds a *big.Int to be encoded and skips it if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBigIntKeyOmitEmpty(key string, v *big.Int) {
+	enc.BigIntKeyOmitEmpty(key, v)
+}
+
+// AddBigIntKeyNullEmpty adds a *big.Int to be encoded and encodes null if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBigIntKeyNullEmpty(key string, v *big.Int) {
+	enc.BigIntKeyNullEmpty(key, v)
+}
+
+// BigIntKey adds a *big.Int to be encoded, must be used inside an object as it will encode a key
+func (enc *Encoder) BigIntKey(key string, v *big.Int) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(10 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.appendBigInt(v, false)
+}
+
+// BigIntKeyOmitEmpty adds a *big.Int to be encoded and skips it if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BigIntKeyOmitEmpty(key string, v *big.Int) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	if v == nil || v.Sign() == 0 {
+		return
+	}
+	enc.grow(10 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.appendBigInt(v, false)
+}
+
+// BigIntKeyNullEmpty adds a *big.Int to be encoded and encodes null if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BigIntKeyNullEmpty(key string, v *big.Int) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(10 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	if v == nil || v.Sign() == 0 {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.appendBigInt(v, false)
+}
+
+// AddBigIntString adds a *big.Int to be encoded as a JSON string, must be used inside a slice or array encoding (does not encode a key)
+func (enc *Encoder) AddBigIntString(v *big.Int) {
+	enc.BigIntString(v)
+}
+
+// BigIntString adds a *big.Int to be encoded as a JSON string, must be used inside a slice or array encoding (does not encode a key).
+// A nil value is encoded as null.
+func (enc *Encoder) BigIntString(v *big.Int) {
+	enc.grow(12)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.appendBigInt(v, true)
+}
+
+// AddBigIntStringKey adds a *big.Int to be encoded as a JSON string, must be used inside an object as it will encode a key
+func (enc *Encoder) AddBigIntStringKey(key string, v *big.Int) {
+	enc.BigIntStringKey(key, v)
+}
+
+// BigIntStringKey adds a *big.Int to be encoded as a JSON string, must be used inside an object as it will encode a key.
+// A nil value is encoded as null.
+func (enc *Encoder) BigIntStringKey(key string, v *big.Int) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(12 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.appendBigInt(v, true)
+}
+
+// EncodeBigFloat encodes a *big.Float to JSON
+func (enc *Encoder) EncodeBigFloat(v *big.Float) error {
+	if enc.isPooled == 1 {
+		panic(InvalidUsagePooledEncoderError("Invalid usage of pooled encoder"))
+	}
+	_, _ = enc.encodeBigFloat(v)
+	if enc.err != nil {
+		return enc.err
+	}
+	_, err := enc.Write()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// encodeBigFloat encodes a *big.Float to JSON
+func (enc *Encoder) encodeBigFloat(v *big.Float) ([]byte, error) {
+	enc.appendBigFloat(v, false)
+	return enc.buf, enc.err
+}
+
+// AddBigFloat adds a *big.Float to be encoded, must be used inside a slice or array encoding (does not encode a key)
+func (enc *Encoder) AddBigFloat(v *big.Float) {
+	enc.BigFloat(v)
+}
+
+// AddBigFloatOmitEmpty adds a *big.Float to be encoded and skips it if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBigFloatOmitEmpty(v *big.Float) {
+	enc.BigFloatOmitEmpty(v)
+}
+
+// AddBigFloatNullEmpty adds a *big.Float to be encoded and encodes null if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBigFloatNullEmpty(v *big.Float) {
+	enc.BigFloatNullEmpty(v)
+}
+
+// BigFloat adds a *big.Float to be encoded, must be used inside a slice or array encoding (does not encode a key)
+func (enc *Encoder) BigFloat(v *big.Float) {
+	enc.grow(10)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.appendBigFloat(v, false)
+}
+
+// BigFloatOmitEmpty adds a *big.Float to be encoded and skips it if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BigFloatOmitEmpty(v *big.Float) {
+	if v == nil || v.Sign() == 0 {
+		return
+	}
+	enc.grow(10)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.appendBigFloat(v, false)
+}
+
+// BigFloatNullEmpty adds a *big.Float to be encoded and encodes null if it is nil or 0,
+// must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BigFloatNullEmpty(v *big.Float) {
+	enc.grow(10)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	if v == nil || v.Sign() == 0 {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.appendBigFloat(v, false)
+}
+
+// AddBigFloatKey adds a *big.Float to be encoded, must be used inside an object as it will encode a key
+func (enc *Encoder) AddBigFloatKey(key string, v *big.Float) {
+	enc.BigFloatKey(key, v)
+}
+
+// AddBigFloatKeyOmitEmpty adds a *big.Float to be encoded and skips it if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBigFloatKeyOmitEmpty(key string, v *big.Float) {
+	enc.BigFloatKeyOmitEmpty(key, v)
+}
+
+// AddBigFloatKeyNullEmpty adds a *big.Float to be encoded and encodes null if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBigFloatKeyNullEmpty(key string, v *big.Float) {
+	enc.BigFloatKeyNullEmpty(key, v)
+}
+
+// BigFloatKey adds a *big.Float to be encoded, must be used inside an object as it will encode a key
+func (enc *Encoder) BigFloatKey(key string, v *big.Float) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(10 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.appendBigFloat(v, false)
+}
+
+// BigFloatKeyOmitEmpty adds a *big.Float to be encoded and skips it if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BigFloatKeyOmitEmpty(key string, v *big.Float) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	if v == nil || v.Sign() == 0 {
+		return
+	}
+	enc.grow(10 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.appendBigFloat(v, false)
+}
+
+// BigFloatKeyNullEmpty adds a *big.Float to be encoded and encodes null if it is nil or 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BigFloatKeyNullEmpty(key string, v *big.Float) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(10 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	if v == nil || v.Sign() == 0 {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	enc.appendBigFloat(v, false)
+}
+
+// AddBigFloatString adds a *big.Float to be encoded as a JSON string, must be used inside a slice or array encoding (does not encode a key)
+func (enc *Encoder) AddBigFloatString(v *big.Float) {
+	enc.BigFloatString(v)
+}
+
+// BigFloatString adds a *big.Float to be encoded as a JSON string, must be used inside a slice or array encoding (does not encode a key).
+// A nil value is encoded as null.
+func (enc *Encoder) BigFloatString(v *big.Float) {
+	enc.grow(12)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.appendBigFloat(v, true)
+}
+
+// AddBigFloatStringKey adds a *big.Float to be encoded as a JSON string, must be used inside an object as it will encode a key
+func (enc *Encoder) AddBigFloatStringKey(key string, v *big.Float) {
+	enc.BigFloatStringKey(key, v)
+}
+
+// BigFloatStringKey adds a *big.Float to be encoded as a JSON string, must be used inside an object as it will encode a key.
+// A nil value is encoded as null.
+func (enc *Encoder) BigFloatStringKey(key string, v *big.Float) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(12 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.appendBigFloat(v, true)
+}
+
+// appendBigInt appends v to the buffer, a nil value is encoded as null.
+func (enc *Encoder) appendBigInt(v *big.Int, quoted bool) {
+	if v == nil {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	if quoted {
+		enc.writeByte('"')
+	}
+	enc.buf = v.Append(enc.buf, 10)
+	if quoted {
+		enc.writeByte('"')
+	}
+}
+
+// appendBigFloat appends v to the buffer with the smallest number of digits representing it exactly,
+// a nil value is encoded as null.
+func (enc *Encoder) appendBigFloat(v *big.Float, quoted bool) {
+	if v == nil {
+		enc.writeBytes(nullBytes)
+		return
+	}
+	if v.IsInf() {
+		enc.err = InvalidMarshalError(fmt.Sprintf("Invalid value %s provided to Marshal, infinity cannot be encoded to JSON", v.String()))
+		enc.writeBytes(nullBytes)
+		return
+	}
+	if quoted {
+		enc.writeByte('"')
+	}
+	enc.buf = v.Append(enc.buf, 'g', -1)
+	if quoted {
+		enc.writeByte('"')
+	}
+}
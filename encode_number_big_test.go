@@ -0,0 +1,178 @@
+package gojay
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustBigInt(s string) *big.Int {
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid big.Int " + s)
+	}
+	return i
+}
+
+func mustBigFloat(s string) *big.Float {
+	f, _, err := big.ParseFloat(s, 10, 200, big.ToNearestEven)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+func TestEncoderBigNumbers(t *testing.T) {
+	testCases := []struct {
+		name         string
+		encode       func(enc *Encoder)
+		expectedJSON string
+	}{
+		{
+			name: "big-int-key",
+			encode: func(enc *Encoder) {
+				enc.BigIntKey("a", mustBigInt("340282366920938463463374607431768211455"))
+				enc.AddBigIntKey("b", mustBigInt("-1"))
+				enc.BigIntKey("c", nil)
+			},
+			expectedJSON: `{"a":340282366920938463463374607431768211455,"b":-1,"c":null}`,
+		},
+		{
+			name: "big-int-key-omit-empty",
+			encode: func(enc *Encoder) {
+				enc.BigIntKeyOmitEmpty("a", new(big.Int))
+				enc.AddBigIntKeyOmitEmpty("b", nil)
+				enc.BigIntKeyOmitEmpty("c", big.NewInt(2))
+			},
+			expectedJSON: `{"c":2}`,
+		},
+		{
+			name: "big-int-key-null-empty",
+			encode: func(enc *Encoder) {
+				enc.BigIntKeyNullEmpty("a", new(big.Int))
+				enc.AddBigIntKeyNullEmpty("b", nil)
+				enc.BigIntKeyNullEmpty("c", big.NewInt(2))
+			},
+			expectedJSON: `{"a":null,"b":null,"c":2}`,
+		},
+		{
+			name: "big-int-string-key",
+			encode: func(enc *Encoder) {
+				enc.BigIntStringKey("a", mustBigInt("18446744073709551616"))
+				enc.AddBigIntStringKey("b", nil)
+			},
+			expectedJSON: `{"a":"18446744073709551616","b":null}`,
+		},
+		{
+			name: "big-float-key",
+			encode: func(enc *Encoder) {
+				enc.BigFloatKey("a", mustBigFloat("3.14159265358979323846264338327950288"))
+				enc.AddBigFloatKey("b", mustBigFloat("-1.5"))
+				enc.BigFloatKey("c", nil)
+			},
+			expectedJSON: `{"a":3.14159265358979323846264338327950288,"b":-1.5,"c":null}`,
+		},
+		{
+			name: "big-float-key-omit-empty",
+			encode: func(enc *Encoder) {
+				enc.BigFloatKeyOmitEmpty("a", new(big.Float))
+				enc.AddBigFloatKeyOmitEmpty("b", nil)
+				enc.BigFloatKeyOmitEmpty("c", big.NewFloat(0.5))
+			},
+			expectedJSON: `{"c":0.5}`,
+		},
+		{
+			name: "big-float-key-null-empty",
+			encode: func(enc *Encoder) {
+				enc.BigFloatKeyNullEmpty("a", new(big.Float))
+				enc.AddBigFloatKeyNullEmpty("b", nil)
+				enc.BigFloatKeyNullEmpty("c", big.NewFloat(0.5))
+			},
+			expectedJSON: `{"a":null,"b":null,"c":0.5}`,
+		},
+		{
+			name: "big-float-string-key",
+			encode: func(enc *Encoder) {
+				enc.BigFloatStringKey("a", mustBigFloat("0.1"))
+				enc.AddBigFloatStringKey("b", nil)
+			},
+			expectedJSON: `{"a":"0.1","b":null}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			builder := &strings.Builder{}
+			enc := BorrowEncoder(builder)
+			defer enc.Release()
+			err := enc.EncodeObject(EncodeObjectFunc(testCase.encode))
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expectedJSON, builder.String())
+		})
+	}
+}
+
+func TestEncoderBigNumbersArray(t *testing.T) {
+	builder := &strings.Builder{}
+	enc := BorrowEncoder(builder)
+	defer enc.Release()
+	err := enc.EncodeArray(EncodeArrayFunc(func(enc *Encoder) {
+		enc.BigInt(big.NewInt(1))
+		enc.AddBigInt(nil)
+		enc.BigIntOmitEmpty(new(big.Int))
+		enc.AddBigIntOmitEmpty(big.NewInt(2))
+		enc.BigIntNullEmpty(new(big.Int))
+		enc.AddBigIntNullEmpty(big.NewInt(3))
+		enc.BigIntString(big.NewInt(4))
+		enc.AddBigIntString(nil)
+		enc.BigFloat(big.NewFloat(1.5))
+		enc.AddBigFloat(nil)
+		enc.BigFloatOmitEmpty(new(big.Float))
+		enc.AddBigFloatOmitEmpty(big.NewFloat(2.5))
+		enc.BigFloatNullEmpty(new(big.Float))
+		enc.AddBigFloatNullEmpty(big.NewFloat(1e21))
+		enc.BigFloatString(big.NewFloat(4.5))
+		enc.AddBigFloatString(nil)
+	}))
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, `[1,null,2,null,3,"4",null,1.5,null,2.5,null,1e+21,"4.5",null]`, builder.String())
+}
+
+func TestEncoderBigNumbersMarshal(t *testing.T) {
+	b, err := Marshal(mustBigInt("-99999999999999999999"))
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "-99999999999999999999", string(b))
+
+	b, err = Marshal(mustBigFloat("1.000000000000000000001"))
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "1.000000000000000000001", string(b))
+
+	_, err = Marshal(big.NewFloat(math.Inf(1)))
+	assert.IsType(t, InvalidMarshalError(""), err, "err should be of type InvalidMarshalError")
+
+	builder := &strings.Builder{}
+	enc := BorrowEncoder(builder)
+	defer enc.Release()
+	err = enc.Encode(mustBigInt("18446744073709551616"))
+	require.Nil(t, err, "err should be nil")
+	err = enc.EncodeBigFloat(mustBigFloat("-0.5"))
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "18446744073709551616-0.5", builder.String())
+}
+
+func TestEncoderBigNumbersRoundTrip(t *testing.T) {
+	f := mustBigFloat("2.718281828459045235360287471352662497757")
+	b, err := MarshalJSONObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.BigFloatKey("f", f)
+		enc.BigIntStringKey("i", mustBigInt("-170141183460469231731687303715884105728"))
+	}))
+	require.Nil(t, err, "err should be nil")
+	v := &testBigNumbers{}
+	err = UnmarshalJSONObject(b, v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, "-170141183460469231731687303715884105728", v.i.String())
+	assert.Equal(t, f.Text('g', -1), v.f.Text('g', -1))
+}
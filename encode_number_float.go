@@ -125,6 +125,7 @@ func (enc *Encoder) Float64(v float64) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.buf = strconv.AppendFloat(enc.buf, v, 'f', -1, 64)
 }
 
@@ -139,6 +140,7 @@ func (enc *Encoder) Float64OmitEmpty(v float64) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.buf = strconv.AppendFloat(enc.buf, v, 'f', -1, 64)
 }
 
@@ -150,6 +152,7 @@ func (enc *Encoder) Float64NullEmpty(v float64) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	if v == 0 {
 		enc.writeBytes(nullBytes)
 		return
@@ -179,10 +182,11 @@ func (enc *Encoder) Float64Key(key string, value float64) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.grow(10)
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	enc.buf = strconv.AppendFloat(enc.buf, value, 'f', -1, 64)
 }
 
@@ -202,9 +206,10 @@ func (enc *Encoder) Float64KeyOmitEmpty(key string, v float64) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	enc.buf = strconv.AppendFloat(enc.buf, v, 'f', -1, 64)
 }
 
@@ -221,9 +226,10 @@ func (enc *Encoder) Float64KeyNullEmpty(key string, v float64) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	if v == 0 {
 		enc.writeBytes(nullBytes)
 		return
@@ -254,6 +260,7 @@ func (enc *Encoder) Float32(v float32) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.buf = strconv.AppendFloat(enc.buf, float64(v), 'f', -1, 32)
 }
 
@@ -268,6 +275,7 @@ func (enc *Encoder) Float32OmitEmpty(v float32) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.buf = strconv.AppendFloat(enc.buf, float64(v), 'f', -1, 32)
 }
 
@@ -279,6 +287,7 @@ func (enc *Encoder) Float32NullEmpty(v float32) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	if v == 0 {
 		enc.writeBytes(nullBytes)
 		return
@@ -315,10 +324,10 @@ func (enc *Encoder) Float32Key(key string, v float32) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeByte('"')
-	enc.writeByte(':')
+	enc.writeKeyEnd(objKey)
 	enc.buf = strconv.AppendFloat(enc.buf, float64(v), 'f', -1, 32)
 }
 
@@ -338,9 +347,10 @@ func (enc *Encoder) Float32KeyOmitEmpty(key string, v float32) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	enc.buf = strconv.AppendFloat(enc.buf, float64(v), 'f', -1, 32)
 }
 
@@ -357,9 +367,10 @@ func (enc *Encoder) Float32KeyNullEmpty(key string, v float32) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	if v == 0 {
 		enc.writeBytes(nullBytes)
 		return
@@ -64,6 +64,7 @@ func (enc *Encoder) Int(v int) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.buf = strconv.AppendInt(enc.buf, int64(v), 10)
 }
 
@@ -78,6 +79,7 @@ func (enc *Encoder) IntOmitEmpty(v int) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.buf = strconv.AppendInt(enc.buf, int64(v), 10)
 }
 
@@ -89,6 +91,7 @@ func (enc *Encoder) IntNullEmpty(v int) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	if v == 0 {
 		enc.writeBytes(nullBytes)
 		return
@@ -125,9 +128,10 @@ func (enc *Encoder) IntKey(key string, v int) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	enc.buf = strconv.AppendInt(enc.buf, int64(v), 10)
 }
 
@@ -147,9 +151,10 @@ func (enc *Encoder) IntKeyOmitEmpty(key string, v int) {
 	if r != '{' && r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	enc.buf = strconv.AppendInt(enc.buf, int64(v), 10)
 }
 
@@ -166,9 +171,10 @@ func (enc *Encoder) IntKeyNullEmpty(key string, v int) {
 	if r != '{' && r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	if v == 0 {
 		enc.writeBytes(nullBytes)
 		return
@@ -200,6 +206,7 @@ func (enc *Encoder) Int64(v int64) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.buf = strconv.AppendInt(enc.buf, v, 10)
 }
 
@@ -214,6 +221,7 @@ func (enc *Encoder) Int64OmitEmpty(v int64) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.buf = strconv.AppendInt(enc.buf, v, 10)
 }
 
@@ -225,6 +233,7 @@ func (enc *Encoder) Int64NullEmpty(v int64) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	if v == 0 {
 		enc.writeBytes(nullBytes)
 		return
@@ -261,9 +270,10 @@ func (enc *Encoder) Int64Key(key string, v int64) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	enc.buf = strconv.AppendInt(enc.buf, v, 10)
 }
 
@@ -278,9 +288,10 @@ func (enc *Encoder) Int64KeyOmitEmpty(key string, v int64) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	enc.buf = strconv.AppendInt(enc.buf, v, 10)
 }
 
@@ -297,9 +308,10 @@ func (enc *Encoder) Int64KeyNullEmpty(key string, v int64) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	if v == 0 {
 		enc.writeBytes(nullBytes)
 		return
@@ -0,0 +1,352 @@
+package gojay
+
+import (
+	"math"
+	"strconv"
+)
+
+// AddInt64String adds an int64 to be encoded as a JSON string, must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddInt64String(v int64) {
+	enc.Int64String(v)
+}
+
+// Int64String adds an int64 to be encoded as a JSON string, like `"9007199254740993"`,
+// so that clients parsing numbers as doubles, like JavaScript, do not lose precision.
+// Must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) Int64String(v int64) {
+	enc.grow(12)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.buf = strconv.AppendInt(enc.buf, v, 10)
+	enc.writeByte('"')
+}
+
+// Int64StringOmitEmpty adds an int64 to be encoded as a JSON string and skips it if its value is 0.
+// Must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) Int64StringOmitEmpty(v int64) {
+	if v == 0 {
+		return
+	}
+	enc.Int64String(v)
+}
+
+// AddInt64StringKey adds an int64 to be encoded as a JSON string, must be used inside an object as it will encode a key.
+func (enc *Encoder) AddInt64StringKey(key string, v int64) {
+	enc.Int64StringKey(key, v)
+}
+
+// Int64StringKey adds an int64 to be encoded as a JSON string, like `"9007199254740993"`.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) Int64StringKey(key string, v int64) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(12 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.writeByte('"')
+	enc.buf = strconv.AppendInt(enc.buf, v, 10)
+	enc.writeByte('"')
+}
+
+// Int64StringKeyOmitEmpty adds an int64 to be encoded as a JSON string and skips it if its value is 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) Int64StringKeyOmitEmpty(key string, v int64) {
+	if v == 0 {
+		return
+	}
+	enc.Int64StringKey(key, v)
+}
+
+// AddUint64String adds a uint64 to be encoded as a JSON string, must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddUint64String(v uint64) {
+	enc.Uint64String(v)
+}
+
+// Uint64String adds a uint64 to be encoded as a JSON string, like `"18446744073709551615"`,
+// so that clients parsing numbers as doubles, like JavaScript, do not lose precision.
+// Must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) Uint64String(v uint64) {
+	enc.grow(12)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.buf = strconv.AppendUint(enc.buf, v, 10)
+	enc.writeByte('"')
+}
+
+// Uint64StringOmitEmpty adds a uint64 to be encoded as a JSON string and skips it if its value is 0.
+// Must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) Uint64StringOmitEmpty(v uint64) {
+	if v == 0 {
+		return
+	}
+	enc.Uint64String(v)
+}
+
+// AddUint64StringKey adds a uint64 to be encoded as a JSON string, must be used inside an object as it will encode a key.
+func (enc *Encoder) AddUint64StringKey(key string, v uint64) {
+	enc.Uint64StringKey(key, v)
+}
+
+// Uint64StringKey adds a uint64 to be encoded as a JSON string, like `"18446744073709551615"`.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) Uint64StringKey(key string, v uint64) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(12 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.writeByte('"')
+	enc.buf = strconv.AppendUint(enc.buf, v, 10)
+	enc.writeByte('"')
+}
+
+// Uint64StringKeyOmitEmpty adds a uint64 to be encoded as a JSON string and skips it if its value is 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) Uint64StringKeyOmitEmpty(key string, v uint64) {
+	if v == 0 {
+		return
+	}
+	enc.Uint64StringKey(key, v)
+}
+
+// AddFloat64String adds a float64 to be encoded as a JSON string, must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddFloat64String(v float64) {
+	enc.Float64String(v)
+}
+
+// Float64String adds a float64 to be encoded as a JSON string, like `"1.5"`.
+// As with encoding/json, the exponent form is used below 1e-6 and from 1e21, like `"1e+21"`.
+// Must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) Float64String(v float64) {
+	enc.grow(12)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.buf = appendQuotedFloat(enc.buf, v, 64)
+	enc.writeByte('"')
+}
+
+// Float64StringOmitEmpty adds a float64 to be encoded as a JSON string and skips it if its value is 0.
+// Must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) Float64StringOmitEmpty(v float64) {
+	if v == 0 {
+		return
+	}
+	enc.Float64String(v)
+}
+
+// AddFloat64StringKey adds a float64 to be encoded as a JSON string, must be used inside an object as it will encode a key.
+func (enc *Encoder) AddFloat64StringKey(key string, v float64) {
+	enc.Float64StringKey(key, v)
+}
+
+// Float64StringKey adds a float64 to be encoded as a JSON string, like `"1.5"`.
+// As with encoding/json, the exponent form is used below 1e-6 and from 1e21, like `"1e+21"`.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) Float64StringKey(key string, v float64) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(12 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.writeByte('"')
+	enc.buf = appendQuotedFloat(enc.buf, v, 64)
+	enc.writeByte('"')
+}
+
+// Float64StringKeyOmitEmpty adds a float64 to be encoded as a JSON string and skips it if its value is 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) Float64StringKeyOmitEmpty(key string, v float64) {
+	if v == 0 {
+		return
+	}
+	enc.Float64StringKey(key, v)
+}
+
+// AddFloat32String adds a float32 to be encoded as a JSON string, must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddFloat32String(v float32) {
+	enc.Float32String(v)
+}
+
+// Float32String adds a float32 to be encoded as a JSON string, like `"1.5"`, formatted as Float64String does.
+// Must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) Float32String(v float32) {
+	enc.grow(12)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.buf = appendQuotedFloat(enc.buf, float64(v), 32)
+	enc.writeByte('"')
+}
+
+// Float32StringOmitEmpty adds a float32 to be encoded as a JSON string and skips it if its value is 0.
+// Must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) Float32StringOmitEmpty(v float32) {
+	if v == 0 {
+		return
+	}
+	enc.Float32String(v)
+}
+
+// AddFloat32StringKey adds a float32 to be encoded as a JSON string, must be used inside an object as it will encode a key.
+func (enc *Encoder) AddFloat32StringKey(key string, v float32) {
+	enc.Float32StringKey(key, v)
+}
+
+// Float32StringKey adds a float32 to be encoded as a JSON string, like `"1.5"`, formatted as Float64String does.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) Float32StringKey(key string, v float32) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(12 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.writeByte('"')
+	enc.buf = appendQuotedFloat(enc.buf, float64(v), 32)
+	enc.writeByte('"')
+}
+
+// Float32StringKeyOmitEmpty adds a float32 to be encoded as a JSON string and skips it if its value is 0.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) Float32StringKeyOmitEmpty(key string, v float32) {
+	if v == 0 {
+		return
+	}
+	enc.Float32StringKey(key, v)
+}
+
+// AddBoolString adds a bool to be encoded as a JSON string, must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) AddBoolString(v bool) {
+	enc.BoolString(v)
+}
+
+// BoolString adds a bool to be encoded as a JSON string, `"true"` or `"false"`.
+// Must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BoolString(v bool) {
+	enc.grow(7)
+	r := enc.getPreviousRune()
+	if r != '[' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.buf = strconv.AppendBool(enc.buf, v)
+	enc.writeByte('"')
+}
+
+// BoolStringOmitEmpty adds a bool to be encoded as a JSON string and skips it if it is false.
+// Must be used inside a slice or array encoding (does not encode a key).
+func (enc *Encoder) BoolStringOmitEmpty(v bool) {
+	if !v {
+		return
+	}
+	enc.BoolString(v)
+}
+
+// AddBoolStringKey adds a bool to be encoded as a JSON string, must be used inside an object as it will encode a key.
+func (enc *Encoder) AddBoolStringKey(key string, v bool) {
+	enc.BoolStringKey(key, v)
+}
+
+// BoolStringKey adds a bool to be encoded as a JSON string, `"true"` or `"false"`.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BoolStringKey(key string, v bool) {
+	if enc.hasKeys {
+		if !enc.keyExists(key) {
+			return
+		}
+	}
+	enc.grow(7 + len(key))
+	r := enc.getPreviousRune()
+	if r != '{' {
+		enc.writeByte(',')
+	}
+	enc.writeIndent()
+	enc.writeByte('"')
+	enc.writeStringEscape(key)
+	enc.writeKeyEnd(objKey)
+	enc.writeByte('"')
+	enc.buf = strconv.AppendBool(enc.buf, v)
+	enc.writeByte('"')
+}
+
+// BoolStringKeyOmitEmpty adds a bool to be encoded as a JSON string and skips it if it is false.
+// Must be used inside an object as it will encode a key.
+func (enc *Encoder) BoolStringKeyOmitEmpty(key string, v bool) {
+	if !v {
+		return
+	}
+	enc.BoolStringKey(key, v)
+}
+
+// appendQuotedFloat appends f formatted as encoding/json does, using the exponent form
+// for values below 1e-6 or from 1e21, bits being 32 or 64.
+func appendQuotedFloat(b []byte, f float64, bits int) []byte {
+	abs := math.Abs(f)
+	format := byte('f')
+	if abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) || bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	b = strconv.AppendFloat(b, f, format, -1, bits)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b
+}
@@ -0,0 +1,124 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoderQuotedNumbersKey(t *testing.T) {
+	testCases := []struct {
+		name         string
+		encode       func(enc *Encoder)
+		expectedJSON string
+	}{
+		{
+			name: "int64-string-key",
+			encode: func(enc *Encoder) {
+				enc.Int64StringKey("a", 9007199254740993)
+				enc.AddInt64StringKey("b", -1)
+				enc.Int64StringKeyOmitEmpty("c", 0)
+				enc.Int64StringKeyOmitEmpty("d", 2)
+			},
+			expectedJSON: `{"a":"9007199254740993","b":"-1","d":"2"}`,
+		},
+		{
+			name: "uint64-string-key",
+			encode: func(enc *Encoder) {
+				enc.Uint64StringKey("a", 18446744073709551615)
+				enc.AddUint64StringKey("b", 0)
+				enc.Uint64StringKeyOmitEmpty("c", 0)
+			},
+			expectedJSON: `{"a":"18446744073709551615","b":"0"}`,
+		},
+		{
+			name: "float64-string-key",
+			encode: func(enc *Encoder) {
+				enc.Float64StringKey("a", 1.5)
+				enc.AddFloat64StringKey("b", 1e21)
+				enc.Float64StringKeyOmitEmpty("c", 0)
+				enc.Float64StringKey("d", 1e-7)
+				enc.Float64StringKey("e", 1e20)
+			},
+			expectedJSON: `{"a":"1.5","b":"1e+21","d":"1e-7","e":"100000000000000000000"}`,
+		},
+		{
+			name: "float32-string-key",
+			encode: func(enc *Encoder) {
+				enc.Float32StringKey("a", 2.3)
+				enc.AddFloat32StringKey("b", 1e21)
+				enc.Float32StringKeyOmitEmpty("c", 0)
+			},
+			expectedJSON: `{"a":"2.3","b":"1e+21"}`,
+		},
+		{
+			name: "bool-string-key",
+			encode: func(enc *Encoder) {
+				enc.BoolStringKey("a", true)
+				enc.AddBoolStringKey("b", false)
+				enc.BoolStringKeyOmitEmpty("c", false)
+			},
+			expectedJSON: `{"a":"true","b":"false"}`,
+		},
+		{
+			name: "escaped-key",
+			encode: func(enc *Encoder) {
+				enc.Int64StringKey(`a"b`, 1)
+			},
+			expectedJSON: `{"a\"b":"1"}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			builder := &strings.Builder{}
+			enc := BorrowEncoder(builder)
+			defer enc.Release()
+			err := enc.EncodeObject(EncodeObjectFunc(testCase.encode))
+			require.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expectedJSON, builder.String())
+		})
+	}
+}
+
+func TestEncoderQuotedNumbers(t *testing.T) {
+	var b strings.Builder
+	var enc = NewEncoder(&b)
+	enc.writeString("[")
+	enc.Int64String(9007199254740993)
+	enc.AddInt64String(0)
+	enc.Int64StringOmitEmpty(0)
+	enc.Uint64String(18446744073709551615)
+	enc.AddUint64String(1)
+	enc.Uint64StringOmitEmpty(0)
+	enc.Float64String(-0.25)
+	enc.AddFloat64String(3)
+	enc.Float64StringOmitEmpty(0)
+	enc.Float32String(0.1)
+	enc.AddFloat32String(1e-7)
+	enc.Float32StringOmitEmpty(0)
+	enc.BoolString(true)
+	enc.AddBoolString(false)
+	enc.BoolStringOmitEmpty(false)
+	enc.Write()
+	assert.Equal(t, `["9007199254740993","0","18446744073709551615","1","-0.25","3","0.1","1e-7","true","false"`, b.String())
+}
+
+func TestEncoderQuotedNumbersRoundTrip(t *testing.T) {
+	builder := &strings.Builder{}
+	enc := BorrowEncoder(builder)
+	defer enc.Release()
+	err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+		enc.Int64StringKey("id", 9007199254740993)
+		enc.Uint64StringKey("count", 18446744073709551615)
+		enc.Float64StringKey("price", 1.25)
+		enc.Int64Key("other", 1)
+	}))
+	require.Nil(t, err, "err should be nil")
+
+	v := &testQuotedObject{}
+	err = UnmarshalJSONObject([]byte(builder.String()), v)
+	require.Nil(t, err, "err should be nil")
+	assert.Equal(t, &testQuotedObject{id: 9007199254740993, count: 18446744073709551615, price: 1.25, other: 1}, v)
+}
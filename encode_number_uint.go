@@ -45,6 +45,7 @@ func (enc *Encoder) Uint64(v uint64) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.buf = strconv.AppendUint(enc.buf, v, 10)
 }
 
@@ -59,6 +60,7 @@ func (enc *Encoder) Uint64OmitEmpty(v uint64) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.buf = strconv.AppendUint(enc.buf, v, 10)
 }
 
@@ -70,6 +72,7 @@ func (enc *Encoder) Uint64NullEmpty(v uint64) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	if v == 0 {
 		enc.writeBytes(nullBytes)
 		return
@@ -106,9 +109,10 @@ func (enc *Encoder) Uint64Key(key string, v uint64) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	enc.buf = strconv.AppendUint(enc.buf, v, 10)
 }
 
@@ -128,9 +132,10 @@ func (enc *Encoder) Uint64KeyOmitEmpty(key string, v uint64) {
 	if r != '{' && r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	enc.buf = strconv.AppendUint(enc.buf, v, 10)
 }
 
@@ -147,9 +152,10 @@ func (enc *Encoder) Uint64KeyNullEmpty(key string, v uint64) {
 	if r != '{' && r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	if v == 0 {
 		enc.writeBytes(nullBytes)
 		return
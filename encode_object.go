@@ -45,7 +45,7 @@ func (enc *Encoder) EncodeObjectKeys(v MarshalerJSONObject, keys []string) error
 
 func (enc *Encoder) encodeObject(v MarshalerJSONObject) ([]byte, error) {
 	enc.grow(512)
-	enc.writeByte('{')
+	enc.writeOpen('{')
 	if !v.IsNil() {
 		v.MarshalJSONObject(enc)
 	}
@@ -53,7 +53,7 @@ func (enc *Encoder) encodeObject(v MarshalerJSONObject) ([]byte, error) {
 		enc.hasKeys = false
 		enc.keys = nil
 	}
-	enc.writeByte('}')
+	enc.writeClose('}')
 	return enc.buf, enc.err
 }
 
@@ -106,8 +106,9 @@ func (enc *Encoder) Object(v MarshalerJSONObject) {
 		if r != '{' && r != '[' {
 			enc.writeByte(',')
 		}
-		enc.writeByte('{')
-		enc.writeByte('}')
+		enc.writeIndent()
+		enc.writeOpen('{')
+		enc.writeClose('}')
 		return
 	}
 	enc.grow(4)
@@ -115,7 +116,8 @@ func (enc *Encoder) Object(v MarshalerJSONObject) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.writeByte('{')
+	enc.writeIndent()
+	enc.writeOpen('{')
 
 	var origHasKeys = enc.hasKeys
 	var origKeys = enc.keys
@@ -127,7 +129,7 @@ func (enc *Encoder) Object(v MarshalerJSONObject) {
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
 
-	enc.writeByte('}')
+	enc.writeClose('}')
 }
 
 // ObjectWithKeys adds an object to be encoded, must be used inside a slice or array encoding (does not encode a key)
@@ -139,8 +141,9 @@ func (enc *Encoder) ObjectWithKeys(v MarshalerJSONObject, keys []string) {
 		if r != '{' && r != '[' {
 			enc.writeByte(',')
 		}
-		enc.writeByte('{')
-		enc.writeByte('}')
+		enc.writeIndent()
+		enc.writeOpen('{')
+		enc.writeClose('}')
 		return
 	}
 	enc.grow(4)
@@ -148,7 +151,8 @@ func (enc *Encoder) ObjectWithKeys(v MarshalerJSONObject, keys []string) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.writeByte('{')
+	enc.writeIndent()
+	enc.writeOpen('{')
 
 	var origKeys = enc.keys
 	var origHasKeys = enc.hasKeys
@@ -160,7 +164,7 @@ func (enc *Encoder) ObjectWithKeys(v MarshalerJSONObject, keys []string) {
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
 
-	enc.writeByte('}')
+	enc.writeClose('}')
 }
 
 // ObjectOmitEmpty adds an object to be encoded or skips it if IsNil returns true.
@@ -175,7 +179,8 @@ func (enc *Encoder) ObjectOmitEmpty(v MarshalerJSONObject) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
-	enc.writeByte('{')
+	enc.writeIndent()
+	enc.writeOpen('{')
 
 	var origHasKeys = enc.hasKeys
 	var origKeys = enc.keys
@@ -187,7 +192,7 @@ func (enc *Encoder) ObjectOmitEmpty(v MarshalerJSONObject) {
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
 
-	enc.writeByte('}')
+	enc.writeClose('}')
 }
 
 // ObjectNullEmpty adds an object to be encoded or skips it if IsNil returns true.
@@ -199,11 +204,12 @@ func (enc *Encoder) ObjectNullEmpty(v MarshalerJSONObject) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	if v.IsNil() {
 		enc.writeBytes(nullBytes)
 		return
 	}
-	enc.writeByte('{')
+	enc.writeOpen('{')
 
 	var origHasKeys = enc.hasKeys
 	var origKeys = enc.keys
@@ -215,7 +221,7 @@ func (enc *Encoder) ObjectNullEmpty(v MarshalerJSONObject) {
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
 
-	enc.writeByte('}')
+	enc.writeClose('}')
 }
 
 // ObjectKey adds a struct to be encoded, must be used inside an object as it will encode a key
@@ -232,10 +238,11 @@ func (enc *Encoder) ObjectKey(key string, v MarshalerJSONObject) {
 		if r != '{' {
 			enc.writeByte(',')
 		}
+		enc.writeIndent()
 		enc.writeByte('"')
 		enc.writeStringEscape(key)
-		enc.writeBytes(objKeyObj)
-		enc.writeByte('}')
+		enc.writeKeyEnd(objKeyObj)
+		enc.writeClose('}')
 		return
 	}
 	enc.grow(5 + len(key))
@@ -243,9 +250,10 @@ func (enc *Encoder) ObjectKey(key string, v MarshalerJSONObject) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKeyObj)
+	enc.writeKeyEnd(objKeyObj)
 
 	var origHasKeys = enc.hasKeys
 	var origKeys = enc.keys
@@ -257,7 +265,7 @@ func (enc *Encoder) ObjectKey(key string, v MarshalerJSONObject) {
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
 
-	enc.writeByte('}')
+	enc.writeClose('}')
 }
 
 // ObjectKeyWithKeys adds a struct to be encoded, must be used inside an object as it will encode a key.
@@ -274,10 +282,11 @@ func (enc *Encoder) ObjectKeyWithKeys(key string, value MarshalerJSONObject, key
 		if r != '{' {
 			enc.writeByte(',')
 		}
+		enc.writeIndent()
 		enc.writeByte('"')
 		enc.writeStringEscape(key)
-		enc.writeBytes(objKeyObj)
-		enc.writeByte('}')
+		enc.writeKeyEnd(objKeyObj)
+		enc.writeClose('}')
 		return
 	}
 	enc.grow(5 + len(key))
@@ -285,9 +294,10 @@ func (enc *Encoder) ObjectKeyWithKeys(key string, value MarshalerJSONObject, key
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKeyObj)
+	enc.writeKeyEnd(objKeyObj)
 	var origKeys = enc.keys
 	var origHasKeys = enc.hasKeys
 	enc.hasKeys = true
@@ -295,7 +305,7 @@ func (enc *Encoder) ObjectKeyWithKeys(key string, value MarshalerJSONObject, key
 	value.MarshalJSONObject(enc)
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
-	enc.writeByte('}')
+	enc.writeClose('}')
 }
 
 // ObjectKeyOmitEmpty adds an object to be encoded or skips it if IsNil returns true.
@@ -315,9 +325,10 @@ func (enc *Encoder) ObjectKeyOmitEmpty(key string, v MarshalerJSONObject) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKeyObj)
+	enc.writeKeyEnd(objKeyObj)
 
 	var origHasKeys = enc.hasKeys
 	var origKeys = enc.keys
@@ -329,7 +340,7 @@ func (enc *Encoder) ObjectKeyOmitEmpty(key string, v MarshalerJSONObject) {
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
 
-	enc.writeByte('}')
+	enc.writeClose('}')
 }
 
 // ObjectKeyNullEmpty adds an object to be encoded or skips it if IsNil returns true.
@@ -346,14 +357,15 @@ func (enc *Encoder) ObjectKeyNullEmpty(key string, v MarshalerJSONObject) {
 	if r != '{' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	if v.IsNil() {
 		enc.writeBytes(nullBytes)
 		return
 	}
-	enc.writeByte('{')
+	enc.writeOpen('{')
 
 	var origHasKeys = enc.hasKeys
 	var origKeys = enc.keys
@@ -365,7 +377,7 @@ func (enc *Encoder) ObjectKeyNullEmpty(key string, v MarshalerJSONObject) {
 	enc.hasKeys = origHasKeys
 	enc.keys = origKeys
 
-	enc.writeByte('}')
+	enc.writeClose('}')
 }
 
 // EncodeObjectFunc is a custom func type implementing MarshaleObject.
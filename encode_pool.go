@@ -40,6 +40,8 @@ func BorrowEncoder(w io.Writer) *Encoder {
 	enc.err = nil
 	enc.hasKeys = false
 	enc.keys = nil
+	enc.SetIndent("", "")
+	enc.flushThreshold = 0
 	return enc
 }
 
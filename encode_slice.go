@@ -111,3 +111,61 @@ func (enc *Encoder) SliceBoolKey(k string, s []bool) {
 		}
 	}))
 }
+
+// AddSliceInterface marshals the given []interface{} s
+func (enc *Encoder) AddSliceInterface(s []interface{}) {
+	enc.SliceInterface(s)
+}
+
+// SliceInterface marshals the given []interface{} s, nil values are encoded as null
+func (enc *Encoder) SliceInterface(s []interface{}) {
+	enc.Array(EncodeArrayFunc(func(enc *Encoder) {
+		for _, v := range s {
+			enc.interfaceOrNull(v)
+		}
+	}))
+}
+
+// AddSliceInterfaceKey marshals the given []interface{} s
+func (enc *Encoder) AddSliceInterfaceKey(k string, s []interface{}) {
+	enc.SliceInterfaceKey(k, s)
+}
+
+// SliceInterfaceKey marshals the given []interface{} s, nil values are encoded as null
+func (enc *Encoder) SliceInterfaceKey(k string, s []interface{}) {
+	enc.ArrayKey(k, EncodeArrayFunc(func(enc *Encoder) {
+		for _, v := range s {
+			enc.interfaceOrNull(v)
+		}
+	}))
+}
+
+// Slice is a slice of objects implementing MarshalerJSONArray,
+// it saves declaring a named slice type to encode a []T:
+//
+//	enc.ArrayKey("users", gojay.Slice[*User](u.users))
+type Slice[T MarshalerJSONObject] []T
+
+// MarshalJSONArray implements MarshalerJSONArray
+func (s Slice[T]) MarshalJSONArray(enc *Encoder) {
+	for _, v := range s {
+		enc.Object(v)
+	}
+}
+
+// IsNil implements MarshalerJSONArray
+func (s Slice[T]) IsNil() bool {
+	return s == nil
+}
+
+// EncodeSlice marshals the given []T s as a JSON array of objects.
+// Must be used inside a slice or array encoding (does not encode a key)
+func EncodeSlice[T MarshalerJSONObject](enc *Encoder, s []T) {
+	enc.Array(Slice[T](s))
+}
+
+// EncodeSliceKey marshals the given []T s as a JSON array of objects with the given key.
+// Must be used inside an object as it will encode a key.
+func EncodeSliceKey[T MarshalerJSONObject](enc *Encoder, key string, s []T) {
+	enc.ArrayKey(key, Slice[T](s))
+}
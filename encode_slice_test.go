@@ -185,3 +185,70 @@ func TestEncodeSliceSlices(t *testing.T) {
 		)
 	}
 }
+
+func (t *testPathItem) MarshalJSONObject(enc *Encoder) {
+	enc.StringKey("name", t.name)
+	enc.IntKey("price", t.price)
+}
+
+func (t *testPathItem) IsNil() bool {
+	return t == nil
+}
+
+func TestEncodeSliceObjects(t *testing.T) {
+	items := []*testPathItem{{name: "foo", price: 1}, {name: "bar"}}
+	testCases := []struct {
+		name   string
+		encode func(enc *Encoder) error
+		json   string
+	}{
+		{
+			name: "slice",
+			encode: func(enc *Encoder) error {
+				return enc.Encode(Slice[*testPathItem](items))
+			},
+			json: `[{"name":"foo","price":1},{"name":"bar","price":0}]`,
+		},
+		{
+			name: "nil slice",
+			encode: func(enc *Encoder) error {
+				return enc.Encode(Slice[*testPathItem](nil))
+			},
+			json: `[]`,
+		},
+		{
+			name: "encode slice",
+			encode: func(enc *Encoder) error {
+				return enc.EncodeArray(EncodeArrayFunc(func(enc *Encoder) {
+					EncodeSlice(enc, items[:1])
+					EncodeSlice(enc, items[1:])
+				}))
+			},
+			json: `[[{"name":"foo","price":1}],[{"name":"bar","price":0}]]`,
+		},
+		{
+			name: "encode slice key",
+			encode: func(enc *Encoder) error {
+				return enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+					EncodeSliceKey(enc, "items", items)
+					EncodeSliceKey(enc, "empty", []*testPathItem{})
+				}))
+			},
+			json: `{"items":[{"name":"foo","price":1},{"name":"bar","price":0}],"empty":[]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				b := strings.Builder{}
+				enc := BorrowEncoder(&b)
+				defer enc.Release()
+				err := testCase.encode(enc)
+				require.Nil(t, err, "err should be nil")
+				require.Equal(t, testCase.json, b.String())
+			},
+		)
+	}
+}
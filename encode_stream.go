@@ -50,6 +50,7 @@ func (s *StreamEncoder) EncodeStream(m MarshalerStream) {
 			ss.done = s.done
 			ss.buf = make([]byte, 0, 512)
 			ss.delimiter = s.delimiter
+			ss.SetIndent(s.indentPrefix, s.indentValue)
 			go consume(s, ss, m)
 			ss.mux.Unlock()
 		}
@@ -142,9 +143,9 @@ func (s *StreamEncoder) AddObject(v MarshalerJSONObject) {
 	if v.IsNil() {
 		return
 	}
-	s.Encoder.writeByte('{')
+	s.Encoder.writeOpen('{')
 	v.MarshalJSONObject(s.Encoder)
-	s.Encoder.writeByte('}')
+	s.Encoder.writeClose('}')
 	s.Encoder.writeByte(s.delimiter)
 }
 
@@ -158,9 +159,9 @@ func (s *StreamEncoder) AddString(v string) {
 
 // AddArray adds an implementation of MarshalerJSONArray to be encoded.
 func (s *StreamEncoder) AddArray(v MarshalerJSONArray) {
-	s.Encoder.writeByte('[')
+	s.Encoder.writeOpen('[')
 	v.MarshalJSONArray(s.Encoder)
-	s.Encoder.writeByte(']')
+	s.Encoder.writeClose(']')
 	s.Encoder.writeByte(s.delimiter)
 }
 
@@ -26,6 +26,8 @@ func (s stream) BorrowEncoder(w io.Writer) *StreamEncoder {
 	streamEnc.Encoder.buf = streamEnc.buf[:0]
 	streamEnc.nConsumer = 1
 	streamEnc.isPooled = 0
+	streamEnc.SetIndent("", "")
+	streamEnc.flushThreshold = 0
 	return streamEnc
 }
 
@@ -34,5 +36,7 @@ func (s stream) borrowEncoder(w io.Writer) *StreamEncoder {
 	streamEnc.isPooled = 0
 	streamEnc.w = w
 	streamEnc.Encoder.err = nil
+	streamEnc.SetIndent("", "")
+	streamEnc.flushThreshold = 0
 	return streamEnc
 }
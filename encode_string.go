@@ -69,10 +69,10 @@ func (enc *Encoder) String(v string) {
 	enc.grow(len(v) + 4)
 	r := enc.getPreviousRune()
 	if r != '[' {
-		enc.writeTwoBytes(',', '"')
-	} else {
-		enc.writeByte('"')
+		enc.writeByte(',')
 	}
+	enc.writeIndent()
+	enc.writeByte('"')
 	enc.writeStringEscape(v)
 	enc.writeByte('"')
 }
@@ -85,10 +85,10 @@ func (enc *Encoder) StringOmitEmpty(v string) {
 	}
 	r := enc.getPreviousRune()
 	if r != '[' {
-		enc.writeTwoBytes(',', '"')
-	} else {
-		enc.writeByte('"')
+		enc.writeByte(',')
 	}
+	enc.writeIndent()
+	enc.writeByte('"')
 	enc.writeStringEscape(v)
 	enc.writeByte('"')
 }
@@ -100,17 +100,16 @@ func (enc *Encoder) StringNullEmpty(v string) {
 	if v == "" {
 		if r != '[' {
 			enc.writeByte(',')
-			enc.writeBytes(nullBytes)
-		} else {
-			enc.writeBytes(nullBytes)
 		}
+		enc.writeIndent()
+		enc.writeBytes(nullBytes)
 		return
 	}
 	if r != '[' {
-		enc.writeTwoBytes(',', '"')
-	} else {
-		enc.writeByte('"')
+		enc.writeByte(',')
 	}
+	enc.writeIndent()
+	enc.writeByte('"')
 	enc.writeStringEscape(v)
 	enc.writeByte('"')
 }
@@ -125,12 +124,12 @@ func (enc *Encoder) StringKey(key, v string) {
 	enc.grow(len(key) + len(v) + 5)
 	r := enc.getPreviousRune()
 	if r != '{' {
-		enc.writeTwoBytes(',', '"')
-	} else {
-		enc.writeByte('"')
+		enc.writeByte(',')
 	}
+	enc.writeIndent()
+	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKeyStr)
+	enc.writeKeyEnd(objKeyStr)
 	enc.writeStringEscape(v)
 	enc.writeByte('"')
 }
@@ -149,12 +148,12 @@ func (enc *Encoder) StringKeyOmitEmpty(key, v string) {
 	enc.grow(len(key) + len(v) + 5)
 	r := enc.getPreviousRune()
 	if r != '{' {
-		enc.writeTwoBytes(',', '"')
-	} else {
-		enc.writeByte('"')
+		enc.writeByte(',')
 	}
+	enc.writeIndent()
+	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKeyStr)
+	enc.writeKeyEnd(objKeyStr)
 	enc.writeStringEscape(v)
 	enc.writeByte('"')
 }
@@ -170,12 +169,12 @@ func (enc *Encoder) StringKeyNullEmpty(key, v string) {
 	enc.grow(len(key) + len(v) + 5)
 	r := enc.getPreviousRune()
 	if r != '{' {
-		enc.writeTwoBytes(',', '"')
-	} else {
-		enc.writeByte('"')
+		enc.writeByte(',')
 	}
+	enc.writeIndent()
+	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKey)
+	enc.writeKeyEnd(objKey)
 	if v == "" {
 		enc.writeBytes(nullBytes)
 		return
@@ -40,12 +40,12 @@ func (enc *Encoder) TimeKey(key string, t *time.Time, format string) {
 	enc.grow(10 + len(key))
 	r := enc.getPreviousRune()
 	if r != '{' {
-		enc.writeTwoBytes(',', '"')
-	} else {
-		enc.writeByte('"')
+		enc.writeByte(',')
 	}
+	enc.writeIndent()
+	enc.writeByte('"')
 	enc.writeStringEscape(key)
-	enc.writeBytes(objKeyStr)
+	enc.writeKeyEnd(objKeyStr)
 	enc.buf = t.AppendFormat(enc.buf, format)
 	enc.writeByte('"')
 }
@@ -62,6 +62,7 @@ func (enc *Encoder) Time(t *time.Time, format string) {
 	if r != '[' {
 		enc.writeByte(',')
 	}
+	enc.writeIndent()
 	enc.writeByte('"')
 	enc.buf = t.AppendFormat(enc.buf, format)
 	enc.writeByte('"')
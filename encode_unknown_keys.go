@@ -0,0 +1,23 @@
+package gojay
+
+import "sort"
+
+// AddUnknownKeys adds the keys of v with their raw value to be encoded, must be used inside an object.
+// Keys are encoded in sorted order so that the output is deterministic.
+func (enc *Encoder) AddUnknownKeys(v UnknownKeys) {
+	enc.UnknownKeys(v)
+}
+
+// UnknownKeys adds the keys of v with their raw value to be encoded, must be used inside an object.
+// Keys are encoded in sorted order so that the output is deterministic.
+func (enc *Encoder) UnknownKeys(v UnknownKeys) {
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		ej := v[k]
+		enc.AddEmbeddedJSONKey(k, &ej)
+	}
+}
@@ -0,0 +1,49 @@
+package gojay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncoderUnknownKeys(t *testing.T) {
+	testCases := []struct {
+		name     string
+		v        *testUnknownKeysObject
+		expected string
+	}{
+		{
+			name:     "nil",
+			v:        &testUnknownKeysObject{name: "a"},
+			expected: `{"name":"a"}`,
+		},
+		{
+			name: "sorted",
+			v: &testUnknownKeysObject{
+				name:  "a",
+				extra: UnknownKeys{"c": EmbeddedJSON(`[1]`), "b": EmbeddedJSON(`{"x":1}`), "a\"": EmbeddedJSON(`"v"`)},
+			},
+			expected: `{"name":"a","a\"":"v","b":{"x":1},"c":[1]}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			builder := &strings.Builder{}
+			enc := BorrowEncoder(builder)
+			defer enc.Release()
+			err := enc.EncodeObject(testCase.v)
+			assert.Nil(t, err, "err should be nil")
+			assert.Equal(t, testCase.expected, builder.String())
+		})
+	}
+	t.Run("first-key", func(t *testing.T) {
+		builder := &strings.Builder{}
+		enc := NewEncoder(builder)
+		err := enc.EncodeObject(EncodeObjectFunc(func(enc *Encoder) {
+			enc.AddUnknownKeys(UnknownKeys{"a": EmbeddedJSON(`1`), "b": EmbeddedJSON(`2`)})
+		}))
+		assert.Nil(t, err, "err should be nil")
+		assert.Equal(t, `{"a":1,"b":2}`, builder.String())
+	})
+}
@@ -3,6 +3,8 @@ package gojay
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 const invalidJSONCharErrorMsg = "Invalid JSON, wrong char '%c' found at position %d"
@@ -16,17 +18,23 @@ func (err InvalidJSONError) Error() string {
 }
 
 func (dec *Decoder) raiseInvalidJSONErr(pos int) error {
+	// the input is cut when the maximum size is exceeded
+	if dec.limitErr != nil {
+		dec.err = dec.limitErr
+		return dec.err
+	}
 	var c byte
 	if len(dec.data) > pos {
 		c = dec.data[pos]
 	}
-	dec.err = InvalidJSONError(
+	var err error = InvalidJSONError(
 		fmt.Sprintf(
 			invalidJSONCharErrorMsg,
 			c,
 			pos,
 		),
 	)
+	dec.err = dec.decorateErr(err, pos, "")
 	return dec.err
 }
 
@@ -41,12 +49,69 @@ func (err InvalidUnmarshalError) Error() string {
 }
 
 func (dec *Decoder) makeInvalidUnmarshalErr(v interface{}) error {
-	return InvalidUnmarshalError(
+	err := InvalidUnmarshalError(
 		fmt.Sprintf(
 			invalidUnmarshalErrorMsg,
 			v,
 		),
 	)
+	if dec.detailed || dec.allErrors {
+		var t string
+		if v != nil {
+			t = fmt.Sprintf("%T", v)
+		}
+		return dec.decorateErr(err, dec.cursor, t)
+	}
+	return err
+}
+
+// setInvalidUnmarshalErr records an InvalidUnmarshalError for the value starting at start,
+// the cursor being already after the value.
+func (dec *Decoder) setInvalidUnmarshalErr(v interface{}, start int) {
+	end := dec.cursor
+	dec.cursor = start
+	dec.err = dec.makeInvalidUnmarshalErr(v)
+	dec.cursor = end
+}
+
+// skipInvalidValue skips the value at the cursor, which cannot be decoded to v,
+// and records the error returned by makeErr at its start.
+// If the value is not valid JSON, only the syntax error is returned.
+func (dec *Decoder) skipInvalidValue(v interface{}, makeErr func(v interface{}) error) error {
+	start := dec.cursor
+	if err := dec.skipData(); err != nil {
+		return err
+	}
+	end := dec.cursor
+	dec.cursor = start
+	dec.err = makeErr(v)
+	dec.cursor = end
+	return nil
+}
+
+// UnknownFieldError is the error returned when an object contains a key which is not decoded
+// and unknown fields are disallowed (see Decoder.DisallowUnknownFields).
+type UnknownFieldError struct {
+	// Key is the unknown key.
+	Key string
+	// Path is the JSON path of the object containing the key.
+	Path string
+}
+
+func (err *UnknownFieldError) Error() string {
+	return fmt.Sprintf("Unknown field %q in object at %s", err.Key, err.Path)
+}
+
+func (dec *Decoder) makeUnknownFieldErr(k string, depth int) error {
+	return dec.decorateErr(
+		&UnknownFieldError{
+			// key must be copied as it points to the buffer
+			Key:  string([]byte(k)),
+			Path: dec.pathString(depth),
+		},
+		dec.cursor,
+		"",
+	)
 }
 
 const invalidMarshalErrorMsg = "Invalid type %T provided to Marshal"
@@ -83,6 +148,150 @@ func (err InvalidUsagePooledEncoderError) Error() string {
 	return string(err)
 }
 
+const invalidPointerErrorMsg = "Invalid JSON pointer %q"
+
+// InvalidPointerError is the error returned when a JSON pointer is malformed,
+// see ParsePointer.
+type InvalidPointerError string
+
+func (err InvalidPointerError) Error() string {
+	return string(err)
+}
+
 // ErrUnmarshalPtrExpected is the error returned when unmarshal expects a pointer value,
 // When using `dec.ObjectNull` or `dec.ArrayNull` for example.
 var ErrUnmarshalPtrExpected = errors.New("Cannot unmarshal to given value, a pointer is expected")
+
+// DecodeError is a structured error returned when decoding fails
+// if detailed errors are enabled on the Decoder (see Decoder.UseDetailedErrors).
+//
+// It wraps the original InvalidJSONError or InvalidUnmarshalError,
+// which can still be retrieved using errors.As.
+type DecodeError struct {
+	// Offset is the position in bytes of the offending token in the input.
+	Offset int
+	// Line and Column are the 1-based position of the offending token, the column is counted in bytes.
+	Line   int
+	Column int
+	// Path is the JSON path of the value being decoded, for example $.items[3].price.
+	Path string
+	// Type is the Go type the value was decoded to, it is empty for syntax errors.
+	Type string
+	// Token is the offending JSON token, it is empty if the end of the input was reached.
+	Token string
+	// Err is the underlying error.
+	Err error
+}
+
+func (err *DecodeError) Error() string {
+	return fmt.Sprintf("%s, at %s (line %d, column %d)", err.Err.Error(), err.Path, err.Line, err.Column)
+}
+
+// Unwrap returns the underlying error.
+func (err *DecodeError) Unwrap() error {
+	return err.Err
+}
+
+// MissingKeysError is the error returned when required keys are missing from an object
+// (see UnmarshalerRequiredKeys).
+type MissingKeysError struct {
+	// Keys are the missing keys.
+	Keys []string
+	// Path is the JSON path of the object, it is empty if the path is not tracked (see Decoder.Path).
+	Path string
+}
+
+func (err *MissingKeysError) Error() string {
+	keys := make([]string, len(err.Keys))
+	for i, k := range err.Keys {
+		keys[i] = strconv.Quote(k)
+	}
+	if err.Path == "" {
+		return fmt.Sprintf("Missing required keys %s", strings.Join(keys, ", "))
+	}
+	return fmt.Sprintf("Missing required keys %s in object at %s", strings.Join(keys, ", "), err.Path)
+}
+
+// MaxDepthError is the error returned when objects and arrays are nested
+// deeper than the maximum depth of the Decoder (see Decoder.SetMaxDepth).
+type MaxDepthError struct {
+	// MaxDepth is the maximum depth which was exceeded.
+	MaxDepth int
+}
+
+func (err *MaxDepthError) Error() string {
+	return fmt.Sprintf("Maximum nesting depth of %d exceeded", err.MaxDepth)
+}
+
+// LimitError is the error returned when the input exceeds a limit of the Decoder,
+// see Decoder.SetMaxBytes, Decoder.SetMaxStringLength, Decoder.SetMaxArrayLength and Decoder.SetMaxObjectKeys.
+type LimitError struct {
+	// Limit is the limit which was exceeded.
+	Limit Limit
+	// Max is the value of the limit.
+	Max int
+}
+
+func (err *LimitError) Error() string {
+	return fmt.Sprintf("Maximum %s of %d exceeded", err.Limit, err.Max)
+}
+
+// decorateErr wraps err in a DecodeError if detailed errors are enabled
+// and collects it if all errors are collected.
+func (dec *Decoder) decorateErr(err error, pos int, t string) error {
+	if dec.allErrors {
+		return dec.collectError(dec.makeDecodeError(err, pos, t))
+	} else if dec.detailed {
+		return dec.makeDecodeError(err, pos, t)
+	}
+	return err
+}
+
+func (dec *Decoder) makeDecodeError(err error, pos int, t string) *DecodeError {
+	line, column := dec.position(pos)
+	return &DecodeError{
+		Offset: dec.offset + pos,
+		Line:   line,
+		Column: column,
+		Path:   dec.Path(),
+		Type:   t,
+		Token:  dec.tokenAt(pos),
+		Err:    err,
+	}
+}
+
+// DecodeErrors is the error returned when all errors are collected (see Decoder.UseAllErrors).
+// It holds every error encountered while decoding, in the order of the input.
+type DecodeErrors []*DecodeError
+
+func (errs DecodeErrors) Error() string {
+	if len(errs) == 1 {
+		return errs[0].Error()
+	}
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(len(errs)))
+	b.WriteString(" errors occurred")
+	for _, err := range errs {
+		b.WriteString("; ")
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the collected errors.
+func (errs DecodeErrors) Unwrap() []error {
+	s := make([]error, len(errs))
+	for i, err := range errs {
+		s[i] = err
+	}
+	return s
+}
+
+// collectError adds err to the errors collected and returns them.
+func (dec *Decoder) collectError(err *DecodeError) error {
+	// an error may be raised again while returning from nested values
+	if n := len(dec.errs); n == 0 || dec.errs[n-1].Offset != err.Offset || dec.errs[n-1].Err != err.Err {
+		dec.errs = append(dec.errs, err)
+	}
+	return dec.errs
+}
@@ -1,6 +1,6 @@
 module github.com/jonas747/gojay
 
-go 1.12
+go 1.18
 
 require (
 	cloud.google.com/go v0.37.0 // indirect
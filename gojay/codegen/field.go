@@ -3,12 +3,14 @@ package codegen
 import (
 	"fmt"
 	"github.com/viant/toolbox"
+	"strconv"
 	"strings"
 )
 
 //Field represents a field.
 type Field struct {
 	Key                string
+	DecodingKeys       string //quoted key and aliases, i.e "userId", "user_id"
 	Init               string
 	OmitEmpty          string
 	TimeLayout         string
@@ -44,6 +46,11 @@ type Field struct {
 	IsSlice         bool
 
 	GojayMethod string
+
+	QuotedType           string //type decoded from a string with the ,string option, i.e int64
+	QuotedDecodingMethod string //decodes QuotedType from a string, i.e Int64String
+	QuotedEncodingMethod string //encodes the field as a string, i.e Int64String
+	QuotedValue          string //field value taken by QuotedEncodingMethod, i.e int64(m.Id)
 }
 
 //NewField returns a new field
@@ -84,6 +91,11 @@ func NewField(owner *Struct, field *toolbox.FieldInfo, fieldType *toolbox.TypeIn
 		result.HelperType = getSliceHelperTypeName(fieldType.Name, field.IsPointerComponent)
 	}
 
+	result.DecodingKeys = strconv.Quote(result.Key)
+	for _, alias := range getKeyAliases(field, result.Key) {
+		result.DecodingKeys += ", " + strconv.Quote(alias)
+	}
+
 	if options := getTagOptions(field.Tag, "timeLayout"); len(options) > 0 {
 		result.TimeLayout = wrapperIfNeeded(options[0], `"`)
 	} else if options := getTagOptions(field.Tag, "timeFormat"); len(options) > 0 {
@@ -150,6 +162,10 @@ func NewField(owner *Struct, field *toolbox.FieldInfo, fieldType *toolbox.TypeIn
 		result.Reset = "nil"
 	}
 
+	if !field.IsSlice {
+		setQuotedCodecs(result)
+	}
+
 	if result.IsPointerComponent {
 		result.ComponentInit = "&" + result.ComponentType + "{}"
 		result.RawComponentType = "*" + result.ComponentType
@@ -166,3 +182,31 @@ func NewField(owner *Struct, field *toolbox.FieldInfo, fieldType *toolbox.TypeIn
 
 	return result, nil
 }
+
+//setQuotedCodecs sets the gojay methods used to code a number or bool field as a string, as for the ,string option.
+func setQuotedCodecs(field *Field) {
+	var encodingType string
+	switch field.Type {
+	case "int", "int8", "int16", "int32", "int64":
+		field.QuotedType = field.Type
+		encodingType = "int64"
+	case "uint":
+		// gojay has no uint decoder, the value is decoded as a uint64
+		field.QuotedType = "uint64"
+		encodingType = "uint64"
+	case "uint8", "uint16", "uint32", "uint64":
+		field.QuotedType = field.Type
+		encodingType = "uint64"
+	case "float32", "float64", "bool":
+		field.QuotedType = field.Type
+		encodingType = field.Type
+	default:
+		return
+	}
+	field.QuotedDecodingMethod = firstLetterToUppercase(field.QuotedType) + "String"
+	field.QuotedEncodingMethod = firstLetterToUppercase(encodingType) + "String"
+	field.QuotedValue = field.DereferenceModifier + field.Accessor
+	if encodingType != field.Type {
+		field.QuotedValue = fmt.Sprintf("%v(%v)", encodingType, field.QuotedValue)
+	}
+}
@@ -63,6 +63,17 @@ func isSkipable(options *Options, field *toolbox.FieldInfo) bool {
 	return false
 }
 
+func hasTagOption(options *Options, field *toolbox.FieldInfo, option string) bool {
+	if options := getTagOptions(field.Tag, options.TagName); len(options) > 1 {
+		for _, candidate := range options[1:] {
+			if candidate == option {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func wrapperIfNeeded(text, wrappingChar string) string {
 	if strings.HasPrefix(text, wrappingChar) {
 		return text
@@ -85,6 +96,19 @@ func getJSONKey(options *Options, field *toolbox.FieldInfo) string {
 	return key
 }
 
+// getKeyAliases returns the distinct keys of the alias tag, other than key
+func getKeyAliases(field *toolbox.FieldInfo, key string) []string {
+	var result []string
+	var seen = map[string]bool{key: true}
+	for _, alias := range getTagOptions(field.Tag, "alias") {
+		if alias = strings.TrimSpace(alias); alias != "" && !seen[alias] {
+			seen[alias] = true
+			result = append(result, alias)
+		}
+	}
+	return result
+}
+
 func normalizeTypeName(typeName string) string {
 	return strings.Replace(typeName, "*", "", strings.Count(typeName, "*"))
 }
@@ -31,6 +31,8 @@ func (s *Struct) generateEncoding(structInfo *toolbox.TypeInfo) (string, error)
 	if err != nil {
 		return "", err
 	}
+	requiredKeys, keyAliases := s.generateRequiredKeys(structInfo.Fields())
+	decodingKeys := s.generateDecodingKeys(structInfo.Fields())
 	var resetCode = ""
 	if s.options.PoolObjects {
 		resetCode, err = s.generateReset(structInfo.Fields())
@@ -39,25 +41,87 @@ func (s *Struct) generateEncoding(structInfo *toolbox.TypeInfo) (string, error)
 		}
 	}
 	var data = struct {
-		Receiver      string
-		Alias         string
-		InitEmbedded  string
-		EncodingCases string
-		DecodingCases string
-		Reset         string
-		FieldCount    int
+		Receiver        string
+		Alias           string
+		InitEmbedded    string
+		EncodingCases   string
+		DecodingCases   string
+		Reset           string
+		FieldCount      int
+		Keys            string
+		KeysVar         string
+		RequiredKeys    string
+		RequiredKeysVar string
+		KeyAliases      string
+		KeyAliasesVar   string
 	}{
-		Receiver:      s.Alias + " *" + s.Name,
-		DecodingCases: strings.Join(decodingCases, "\n"),
-		EncodingCases: strings.Join(encodingCases, "\n"),
-		FieldCount:    len(decodingCases),
-		InitEmbedded:  initEmbedded,
-		Reset:         resetCode,
-		Alias:         s.Alias,
+		Receiver:        s.Alias + " *" + s.Name,
+		DecodingCases:   strings.Join(decodingCases, "\n"),
+		EncodingCases:   strings.Join(encodingCases, "\n"),
+		FieldCount:      len(decodingCases),
+		InitEmbedded:    initEmbedded,
+		Reset:           resetCode,
+		Alias:           s.Alias,
+		Keys:            strings.Join(decodingKeys, ", "),
+		KeysVar:         firstLetterToLowercase(s.Name) + "Keys",
+		RequiredKeys:    strings.Join(requiredKeys, ", "),
+		RequiredKeysVar: firstLetterToLowercase(s.Name) + "RequiredKeys",
+		KeyAliases:      strings.Join(keyAliases, ", "),
+		KeyAliasesVar:   firstLetterToLowercase(s.Name) + "KeyAliases",
 	}
 	return expandBlockTemplate(encodingStructType, data)
 }
 
+// generateDecodingKeys returns the quoted keys and aliases of the fields, as they are matched by UnmarshalJSONObject
+func (s *Struct) generateDecodingKeys(fields []*toolbox.FieldInfo) []string {
+	keys := []string{}
+	for i := range fields {
+		if isSkipable(s.options, fields[i]) {
+			continue
+		}
+		if fields[i].IsAnonymous {
+			if fieldTypeInfo := s.Type(normalizeTypeName(fields[i].TypeName)); fieldTypeInfo != nil {
+				keys = append(keys, s.generateDecodingKeys(fieldTypeInfo.Fields())...)
+			}
+			continue
+		}
+		key := getJSONKey(s.options, fields[i])
+		keys = append(keys, fmt.Sprintf("%q", key))
+		for _, alias := range getKeyAliases(fields[i], key) {
+			keys = append(keys, fmt.Sprintf("%q", alias))
+		}
+	}
+	return keys
+}
+
+// generateRequiredKeys returns the quoted keys of the fields having the required tag option,
+// and their aliases as map entries, i.e "userId": "id"
+func (s *Struct) generateRequiredKeys(fields []*toolbox.FieldInfo) ([]string, []string) {
+	requiredKeys := []string{}
+	keyAliases := []string{}
+	for i := range fields {
+		if isSkipable(s.options, fields[i]) {
+			continue
+		}
+		if fields[i].IsAnonymous {
+			if fieldTypeInfo := s.Type(normalizeTypeName(fields[i].TypeName)); fieldTypeInfo != nil {
+				embeddedKeys, embeddedAliases := s.generateRequiredKeys(fieldTypeInfo.Fields())
+				requiredKeys = append(requiredKeys, embeddedKeys...)
+				keyAliases = append(keyAliases, embeddedAliases...)
+			}
+			continue
+		}
+		if hasTagOption(s.options, fields[i], "required") {
+			key := getJSONKey(s.options, fields[i])
+			requiredKeys = append(requiredKeys, fmt.Sprintf("%q", key))
+			for _, alias := range getKeyAliases(fields[i], key) {
+				keyAliases = append(keyAliases, fmt.Sprintf("%q: %q", alias, key))
+			}
+		}
+	}
+	return requiredKeys, keyAliases
+}
+
 func (s *Struct) generateReset(fields []*toolbox.FieldInfo) (string, error) {
 	fieldReset, err := s.generateFieldReset(fields)
 	if err != nil {
@@ -143,6 +207,9 @@ func (s *Struct) generateFieldDecoding(fields []*toolbox.FieldInfo) (string, []s
 		switch field.Type {
 		case "string", "bool", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
 			templateKey = decodeBaseType
+			if field.QuotedType != "" && hasTagOption(s.options, fields[i], "string") {
+				templateKey = decodeQuotedType
+			}
 		case "[]string", "[]bool", "[]int", "[]int8", "[]int16", "[]int32", "[]int64", "[]uint", "[]uint8", "[]uint16", "[]uint32", "[]uint64", "[]float32", "[]float64":
 			templateKey = decodeBaseTypeSlice
 			s.generatePrimitiveArray(field)
@@ -254,6 +321,9 @@ func (s *Struct) generateFieldEncoding(fields []*toolbox.FieldInfo) ([]string, e
 		switch field.Type {
 		case "string", "bool", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
 			templateKey = encodeBaseType
+			if field.QuotedType != "" && hasTagOption(s.options, fields[i], "string") {
+				templateKey = encodeQuotedType
+			}
 		case "[]string", "[]bool", "[]int", "[]int8", "[]int16", "[]int32", "[]int64", "[]uint", "[]uint8", "[]uint16", "[]uint32", "[]uint64", "[]float32", "[]float64":
 			templateKey = encodeBaseTypeSlice
 			s.generatePrimitiveArray(field)
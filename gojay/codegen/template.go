@@ -27,13 +27,16 @@ const (
 	decodeUnknown
 	encodeUnknown
 
+	decodeQuotedType
+	encodeQuotedType
+
 	resetFieldValue
 	poolInstanceRelease
 	poolSliceInstanceRelease
 )
 
 var fieldTemplate = map[int]string{
-	decodeBaseType: `		case "{{.Key}}":
+	decodeBaseType: `		case {{.DecodingKeys}}:
 {{if .IsPointer}}			var value {{.Type}}
 			err := dec.{{.DecodingMethod}}(&value)
 			if err == nil {
@@ -44,7 +47,23 @@ var fieldTemplate = map[int]string{
 `,
 	encodeBaseType: `    enc.{{.EncodingMethod}}Key{{.OmitEmpty}}("{{.Key}}", {{.DereferenceModifier}}{{.Accessor}})`,
 
-	decodeBaseTypeSlice: `		case "{{.Key}}":
+	decodeQuotedType: `		case {{.DecodingKeys}}:
+{{if or .IsPointer (ne .QuotedType .Type)}}			var value {{.QuotedType}}
+			err := dec.{{.QuotedDecodingMethod}}(&value)
+			if err == nil {
+{{if and .IsPointer (eq .QuotedType .Type)}}				{{.Accessor}} = &value
+{{else if .IsPointer}}				var typed = {{.Type}}(value)
+				{{.Accessor}} = &typed
+{{else}}				{{.Accessor}} = {{.Type}}(value)
+{{end}}			}
+			return err
+{{else}}			return dec.{{.QuotedDecodingMethod}}(&{{.Accessor}}){{end}}
+`,
+	encodeQuotedType: `{{if .IsPointer}}    if {{.Accessor}} != nil {
+        enc.{{.QuotedEncodingMethod}}Key("{{.Key}}", {{.QuotedValue}})
+    }{{else if eq .OmitEmpty "OmitEmpty"}}    enc.{{.QuotedEncodingMethod}}KeyOmitEmpty("{{.Key}}", {{.QuotedValue}}){{else}}    enc.{{.QuotedEncodingMethod}}Key("{{.Key}}", {{.QuotedValue}}){{end}}`,
+
+	decodeBaseTypeSlice: `		case {{.DecodingKeys}}:
 			var aSlice = {{.HelperType}}{}
 			err := dec.Array(&aSlice)
 			if err == nil && len(aSlice) > 0 {
@@ -55,7 +74,7 @@ var fieldTemplate = map[int]string{
 	encodeBaseTypeSlice: `    var {{.Var}}Slice = {{.HelperType}}({{.Accessor}})
     enc.ArrayKey{{.OmitEmpty}}("{{.Key}}",{{.Var}}Slice)`,
 
-	decodeRawType: `		case "{{.Key}}":
+	decodeRawType: `		case {{.DecodingKeys}}:
 			var value = gojay.EmbeddedJSON{}
 			err := dec.AddEmbeddedJSON(&value)
 			if err == nil && len(value) > 0 {
@@ -66,7 +85,7 @@ var fieldTemplate = map[int]string{
 
 	encodeRawType: `    var {{.Var}}Slice = gojay.EmbeddedJSON({{.Accessor}})
     enc.AddEmbeddedJSONKey{{.OmitEmpty}}("{{.Key}}", &{{.Var}}Slice)`,
-	decodeStruct: `		case "{{.Key}}":{{if .IsPointer}}
+	decodeStruct: `		case {{.DecodingKeys}}:{{if .IsPointer}}
 			var value = {{.Init}}
 			err := dec.Object(value)
 			if err == nil {
@@ -79,7 +98,7 @@ var fieldTemplate = map[int]string{
 `,
 	encodeStruct: `    enc.ObjectKey{{.OmitEmpty}}("{{.Key}}", {{.PointerModifier}}{{.Accessor}})`,
 
-	decodeStructSlice: `		case "{{.Key}}":
+	decodeStructSlice: `		case {{.DecodingKeys}}:
 			   var aSlice = {{.HelperType}}{}
 			   err := dec.Array(&aSlice)
 			   if err == nil && len(aSlice) > 0 {
@@ -91,7 +110,7 @@ var fieldTemplate = map[int]string{
 	encodeStructSlice: `    var {{.Var}}Slice = {{.HelperType}}({{.Accessor}})
     enc.ArrayKey{{.OmitEmpty}}("{{.Key}}", {{.DereferenceModifier}}{{.Var}}Slice)`,
 
-	decodeTime: `		case "{{.Key}}":
+	decodeTime: `		case {{.DecodingKeys}}:
 			var format = {{.TimeLayout}}
 			var value = {{.Init}}
 			err := dec.Time({{.PointerModifier}}value, format)
@@ -104,7 +123,7 @@ var fieldTemplate = map[int]string{
 	encodeTime: `{{if .IsPointer}}    if {{.Accessor}} != nil {
         enc.TimeKey("{{.Key}}", {{.PointerModifier}}{{.Accessor}}, {{.TimeLayout}})
     }{{else}}    enc.TimeKey("{{.Key}}", {{.PointerModifier}}{{.Accessor}}, {{.TimeLayout}}){{end}}`,
-	decodeSQLNull: `		case "{{.Key}}":
+	decodeSQLNull: `		case {{.DecodingKeys}}:
 			var value = {{.Init}}
 			err := dec.SQLNull{{.NullType}}({{.PointerModifier}}value)
 			if err == nil {
@@ -115,7 +134,7 @@ var fieldTemplate = map[int]string{
 	encodeSQLNull: `{{if .IsPointer}}    if {{.Accessor}} != nil {
         enc.SQLNull{{.NullType}}Key{{.OmitEmpty}}("{{.Key}}", {{.PointerModifier}}{{.Accessor}})
     }{{else}}    enc.SQLNull{{.NullType}}Key{{.OmitEmpty}}("{{.Key}}", {{.PointerModifier}}{{.Accessor}}){{end}}`,
-	decodeUnknown: `		case "{{.Key}}":
+	decodeUnknown: `		case {{.DecodingKeys}}:
 			return dec.Any({{.PointerModifier}}{{.Accessor}})
 `,
 	encodeUnknown: `{{if .IsPointer}}    if {{.Accessor}} != nil {	
@@ -181,7 +200,22 @@ func ({{.Receiver}}) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 
 // NKeys returns the number of keys to unmarshal
 func ({{.Receiver}}) NKeys() int { return {{.FieldCount}} }
+{{if .Keys}}
+var {{.KeysVar}} = []string{ {{.Keys}} }
+
+// Keys returns the keys to unmarshal, to match them ignoring case
+func ({{.Receiver}}) Keys() []string { return {{.KeysVar}} }
+{{end}}{{if .RequiredKeys}}
+var {{.RequiredKeysVar}} = []string{ {{.RequiredKeys}} }
 
+// RequiredKeys returns the keys which must be present to unmarshal
+func ({{.Receiver}}) RequiredKeys() []string { return {{.RequiredKeysVar}} }
+{{end}}{{if .KeyAliases}}
+var {{.KeyAliasesVar}} = map[string]string{ {{.KeyAliases}} }
+
+// KeyAliases returns the aliases of the required keys
+func ({{.Receiver}}) KeyAliases() map[string]string { return {{.KeyAliasesVar}} }
+{{end}}
 {{.Reset}}
 
 `,
@@ -4,58 +4,10 @@ package annotated_struct
 
 import (
 	"database/sql"
-	"github.com/francoispqt/gojay"
+	"github.com/jonas747/gojay"
 	"time"
 )
 
-type Ints []int
-
-// UnmarshalJSONArray decodes JSON array elements into slice
-func (a *Ints) UnmarshalJSONArray(dec *gojay.Decoder) error {
-	var value int
-	if err := dec.Int(&value); err != nil {
-		return err
-	}
-	*a = append(*a, value)
-	return nil
-}
-
-// MarshalJSONArray encodes arrays into JSON
-func (a Ints) MarshalJSONArray(enc *gojay.Encoder) {
-	for _, item := range a {
-		enc.Int(item)
-	}
-}
-
-// IsNil checks if array is nil
-func (a Ints) IsNil() bool {
-	return len(a) == 0
-}
-
-type Float32s []float32
-
-// UnmarshalJSONArray decodes JSON array elements into slice
-func (a *Float32s) UnmarshalJSONArray(dec *gojay.Decoder) error {
-	var value float32
-	if err := dec.Float32(&value); err != nil {
-		return err
-	}
-	*a = append(*a, value)
-	return nil
-}
-
-// MarshalJSONArray encodes arrays into JSON
-func (a Float32s) MarshalJSONArray(enc *gojay.Encoder) {
-	for _, item := range a {
-		enc.Float32(item)
-	}
-}
-
-// IsNil checks if array is nil
-func (a Float32s) IsNil() bool {
-	return len(a) == 0
-}
-
 type SubMessagesPtr []*SubMessage
 
 func (s *SubMessagesPtr) UnmarshalJSONArray(dec *gojay.Decoder) error {
@@ -98,33 +50,64 @@ func (s SubMessages) IsNil() bool {
 	return len(s) == 0
 }
 
-// MarshalJSONObject implements MarshalerJSONObject
-func (p *Payload) MarshalJSONObject(enc *gojay.Encoder) {
+type Float32s []float32
 
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Float32s) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value float32
+	if err := dec.Float32(&value); err != nil {
+		return err
+	}
+	*a = append(*a, value)
+	return nil
 }
 
-// IsNil checks if instance is nil
-func (p *Payload) IsNil() bool {
-	return p == nil
+// MarshalJSONArray encodes arrays into JSON
+func (a Float32s) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Float32(item)
+	}
 }
 
-// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
-func (p *Payload) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+// IsNil checks if array is nil
+func (a Float32s) IsNil() bool {
+	return len(a) == 0
+}
 
-	switch k {
+type Ints []int
 
+// UnmarshalJSONArray decodes JSON array elements into slice
+func (a *Ints) UnmarshalJSONArray(dec *gojay.Decoder) error {
+	var value int
+	if err := dec.Int(&value); err != nil {
+		return err
 	}
+	*a = append(*a, value)
 	return nil
 }
 
-// NKeys returns the number of keys to unmarshal
-func (p *Payload) NKeys() int { return 0 }
+// MarshalJSONArray encodes arrays into JSON
+func (a Ints) MarshalJSONArray(enc *gojay.Encoder) {
+	for _, item := range a {
+		enc.Int(item)
+	}
+}
+
+// IsNil checks if array is nil
+func (a Ints) IsNil() bool {
+	return len(a) == 0
+}
 
 // MarshalJSONObject implements MarshalerJSONObject
 func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
 	enc.IntKey("id", m.Id)
 	enc.StringKey("name", m.Name)
 	enc.Float64Key("price", m.Price)
+	enc.Int64StringKeyOmitEmpty("serial", m.Serial)
+	if m.Rank != nil {
+		enc.Int64StringKey("rank", int64(*m.Rank))
+	}
+	enc.Float32StringKeyOmitEmpty("ratio", m.Ratio)
 	var intsSlice = Ints(m.Ints)
 	enc.ArrayKey("ints", intsSlice)
 	var floatsSlice = Float32s(m.Floats)
@@ -155,12 +138,26 @@ func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 	case "id":
 		return dec.Int(&m.Id)
 
-	case "name":
+	case "name", "title":
 		return dec.String(&m.Name)
 
-	case "price":
+	case "price", "cost", "Price":
 		return dec.Float64(&m.Price)
 
+	case "serial":
+		return dec.Int64String(&m.Serial)
+
+	case "rank":
+		var value int8
+		err := dec.Int8String(&value)
+		if err == nil {
+			m.Rank = &value
+		}
+		return err
+
+	case "ratio":
+		return dec.Float32String(&m.Ratio)
+
 	case "ints":
 		var aSlice = Ints{}
 		err := dec.Array(&aSlice)
@@ -236,7 +233,44 @@ func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 }
 
 // NKeys returns the number of keys to unmarshal
-func (m *Message) NKeys() int { return 12 }
+func (m *Message) NKeys() int { return 15 }
+
+var messageKeys = []string{"id", "name", "title", "price", "cost", "Price", "serial", "rank", "ratio", "ints", "floats", "subMessageX", "messagesX", "SubMessageY", "MessagesY", "enabled", "data", "sqlNullString"}
+
+// Keys returns the keys to unmarshal, to match them ignoring case
+func (m *Message) Keys() []string { return messageKeys }
+
+var messageRequiredKeys = []string{"id", "name"}
+
+// RequiredKeys returns the keys which must be present to unmarshal
+func (m *Message) RequiredKeys() []string { return messageRequiredKeys }
+
+var messageKeyAliases = map[string]string{"title": "name"}
+
+// KeyAliases returns the aliases of the required keys
+func (m *Message) KeyAliases() map[string]string { return messageKeyAliases }
+
+// MarshalJSONObject implements MarshalerJSONObject
+func (p *Payload) MarshalJSONObject(enc *gojay.Encoder) {
+
+}
+
+// IsNil checks if instance is nil
+func (p *Payload) IsNil() bool {
+	return p == nil
+}
+
+// UnmarshalJSONObject implements gojay's UnmarshalerJSONObject
+func (p *Payload) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
+
+	switch k {
+
+	}
+	return nil
+}
+
+// NKeys returns the number of keys to unmarshal
+func (p *Payload) NKeys() int { return 0 }
 
 // MarshalJSONObject implements MarshalerJSONObject
 func (m *SubMessage) MarshalJSONObject(enc *gojay.Encoder) {
@@ -287,3 +321,8 @@ func (m *SubMessage) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 
 // NKeys returns the number of keys to unmarshal
 func (m *SubMessage) NKeys() int { return 4 }
+
+var subMessageKeys = []string{"id", "description", "startDate", "endDate"}
+
+// Keys returns the keys to unmarshal, to match them ignoring case
+func (m *SubMessage) Keys() []string { return subMessageKeys }
@@ -6,7 +6,7 @@ import (
 	"log"
 	"testing"
 
-	"github.com/francoispqt/gojay"
+	"github.com/jonas747/gojay"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -124,3 +124,90 @@ func TestMessage_Marshal(t *testing.T) {
 	require.JSONEq(t, jsonData, JSON)
 
 }
+
+func TestMessage_RequiredKeys(t *testing.T) {
+	message := &Message{}
+	assert.Equal(t, []string{"id", "name"}, message.RequiredKeys())
+
+	err := gojay.UnmarshalJSONObject([]byte(`{"id":1,"price":1.5}`), message)
+	require.IsType(t, &gojay.MissingKeysError{}, err)
+	assert.Equal(t, []string{"name"}, err.(*gojay.MissingKeysError).Keys)
+
+	err = gojay.UnmarshalJSONObject([]byte(`{"subMessageX":{"id":1},"messagesX":[{"id":2}]}`), &Message{})
+	require.IsType(t, &gojay.MissingKeysError{}, err)
+	assert.Equal(t, []string{"id", "name"}, err.(*gojay.MissingKeysError).Keys)
+
+	err = gojay.UnmarshalJSONObject([]byte(`{"name":"a","id":1}`), &Message{})
+	assert.Nil(t, err)
+}
+
+func TestMessage_UnmarshalAlias(t *testing.T) {
+	for _, key := range []string{"price", "cost", "Price"} {
+		message := &Message{}
+		err := gojay.UnmarshalJSONObject([]byte(`{"id":1,"name":"a","`+key+`":1.5}`), message)
+		require.Nil(t, err)
+		assert.Equal(t, 1.5, message.Price, key)
+	}
+}
+
+func TestMessage_RequiredKeysAlias(t *testing.T) {
+	message := &Message{}
+	err := gojay.UnmarshalJSONObject([]byte(`{"id":1,"title":"a"}`), message)
+	require.Nil(t, err, "a required key should be found under its alias")
+	assert.Equal(t, "a", message.Name)
+
+	err = gojay.UnmarshalJSONObject([]byte(`{"id":1,"cost":1.5}`), &Message{})
+	require.IsType(t, &gojay.MissingKeysError{}, err)
+	assert.Equal(t, []string{"name"}, err.(*gojay.MissingKeysError).Keys)
+}
+
+func TestMessage_CaseInsensitiveKeys(t *testing.T) {
+	message := &Message{}
+	err := gojay.UnmarshalJSONObjectWithOptions(
+		[]byte(`{"ID":1,"Title":"a","COST":1.5,"SubMessageX":{"Id":2,"StartDate":"2018-01-01 10:00:00"}}`),
+		message,
+		gojay.CaseInsensitiveKeys(),
+	)
+	require.Nil(t, err)
+	assert.Equal(t, 1, message.Id)
+	assert.Equal(t, "a", message.Name)
+	assert.Equal(t, 1.5, message.Price)
+	require.NotNil(t, message.SubMessageX)
+	assert.Equal(t, 2, message.SubMessageX.Id)
+	assert.Equal(t, 2018, message.SubMessageX.StartTime.Year())
+}
+
+func TestMessage_SerialString(t *testing.T) {
+	message := &Message{}
+	err := gojay.UnmarshalJSONObject([]byte(`{"id":1,"name":"a","serial":"9007199254740993"}`), message)
+	require.Nil(t, err)
+	assert.Equal(t, int64(9007199254740993), message.Serial)
+
+	err = gojay.UnmarshalJSONObject([]byte(`{"id":1,"name":"a","serial":"1.5"}`), &Message{})
+	assert.NotNil(t, err)
+	err = gojay.UnmarshalJSONObject([]byte(`{"id":1,"name":"a","serial":""}`), &Message{})
+	assert.NotNil(t, err, "an empty string should not decode to 0")
+
+	message = &Message{}
+	err = gojay.UnmarshalJSONObject([]byte(`{"id":1,"name":"a","rank":"-3","ratio":"0.5"}`), message)
+	require.Nil(t, err)
+	require.NotNil(t, message.Rank)
+	assert.Equal(t, int8(-3), *message.Rank)
+	assert.Equal(t, float32(0.5), message.Ratio)
+	err = gojay.UnmarshalJSONObject([]byte(`{"id":1,"name":"a","rank":"128"}`), &Message{})
+	assert.NotNil(t, err, "a value overflowing the field should not be accepted")
+
+	var isTrue = true
+	data, err := gojay.MarshalJSONObject(&Message{Serial: 9007199254740993, IsTrue: &isTrue})
+	require.Nil(t, err)
+	assert.Contains(t, string(data), `"serial":"9007199254740993"`)
+	var rank int8 = -3
+	data, err = gojay.MarshalJSONObject(&Message{Rank: &rank, Ratio: 1e21, IsTrue: &isTrue})
+	require.Nil(t, err)
+	assert.Contains(t, string(data), `"rank":"-3","ratio":"1e+21"`)
+	data, err = gojay.MarshalJSONObject(&Message{IsTrue: &isTrue})
+	require.Nil(t, err)
+	assert.NotContains(t, string(data), `"serial"`)
+	assert.NotContains(t, string(data), `"rank"`)
+	assert.NotContains(t, string(data), `"ratio"`)
+}
@@ -5,9 +5,12 @@ import "database/sql"
 type Payload []byte
 
 type Message struct {
-	Id            int           `json:"id"`
-	Name          string        `json:"name"`
-	Price         float64       `json:"price"`
+	Id            int           `json:"id,required"`
+	Name          string        `json:"name,required" alias:"title"`
+	Price         float64       `json:"price" alias:"cost,Price"`
+	Serial        int64         `json:"serial,string,omitempty"`
+	Rank          *int8         `json:"rank,string"`
+	Ratio         float32       `json:"ratio,string,omitempty"`
 	Ints          []int         `json:"ints"`
 	Floats        []float32     `json:"floats"`
 	SubMessageX   *SubMessage   `json:"subMessageX"`
@@ -4,7 +4,7 @@ package basic_struct
 
 import (
 	"database/sql"
-	"github.com/francoispqt/gojay"
+	"github.com/jonas747/gojay"
 	"time"
 )
 
@@ -148,6 +148,11 @@ func (m *SubMessage) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 // NKeys returns the number of keys to unmarshal
 func (m *SubMessage) NKeys() int { return 4 }
 
+var subMessageKeys = []string{"Id", "Description", "StartTime", "EndTime"}
+
+// Keys returns the keys to unmarshal, to match them ignoring case
+func (m *SubMessage) Keys() []string { return subMessageKeys }
+
 // MarshalJSONObject implements MarshalerJSONObject
 func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
 	enc.IntKey("Id", m.Id)
@@ -265,3 +270,8 @@ func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 
 // NKeys returns the number of keys to unmarshal
 func (m *Message) NKeys() int { return 12 }
+
+var messageKeys = []string{"Id", "Name", "Price", "Ints", "Floats", "SubMessageX", "MessagesX", "SubMessageY", "MessagesY", "IsTrue", "Payload", "SQLNullString"}
+
+// Keys returns the keys to unmarshal, to match them ignoring case
+func (m *Message) Keys() []string { return messageKeys }
@@ -5,7 +5,7 @@ import (
 	"database/sql"
 	"testing"
 
-	"github.com/francoispqt/gojay"
+	"github.com/jonas747/gojay"
 	"github.com/stretchr/testify/require"
 )
 
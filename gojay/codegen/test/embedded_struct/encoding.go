@@ -3,7 +3,7 @@
 package embedded_struct
 
 import (
-	"github.com/francoispqt/gojay"
+	"github.com/jonas747/gojay"
 	"time"
 )
 
@@ -143,6 +143,11 @@ func (m *SubMessage) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 // NKeys returns the number of keys to unmarshal
 func (m *SubMessage) NKeys() int { return 3 }
 
+var subMessageKeys = []string{"Description", "StartTime", "EndTime"}
+
+// Keys returns the keys to unmarshal, to match them ignoring case
+func (m *SubMessage) Keys() []string { return subMessageKeys }
+
 // MarshalJSONObject implements MarshalerJSONObject
 func (m *Message) MarshalJSONObject(enc *gojay.Encoder) {
 	if m.BaseId != nil {
@@ -280,6 +285,11 @@ func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 // NKeys returns the number of keys to unmarshal
 func (m *Message) NKeys() int { return 14 }
 
+var messageKeys = []string{"Id", "Name", "Description", "StartTime", "EndTime", "Price", "Ints", "Floats", "SubMessageX", "MessagesX", "SubMessageY", "MessagesY", "IsTrue", "Payload"}
+
+// Keys returns the keys to unmarshal, to match them ignoring case
+func (m *Message) Keys() []string { return messageKeys }
+
 // MarshalJSONObject implements MarshalerJSONObject
 func (i *BaseId) MarshalJSONObject(enc *gojay.Encoder) {
 	enc.IntKey("Id", i.Id)
@@ -307,3 +317,8 @@ func (i *BaseId) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 
 // NKeys returns the number of keys to unmarshal
 func (i *BaseId) NKeys() int { return 2 }
+
+var baseIdKeys = []string{"Id", "Name"}
+
+// Keys returns the keys to unmarshal, to match them ignoring case
+func (i *BaseId) Keys() []string { return baseIdKeys }
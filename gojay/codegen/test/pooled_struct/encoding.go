@@ -3,7 +3,7 @@
 package pooled_struct
 
 import (
-	"github.com/francoispqt/gojay"
+	"github.com/jonas747/gojay"
 	"sync"
 	"time"
 )
@@ -164,6 +164,11 @@ func (m *SubMessage) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 // NKeys returns the number of keys to unmarshal
 func (m *SubMessage) NKeys() int { return 4 }
 
+var subMessageKeys = []string{"Id", "Description", "StartTime", "EndTime"}
+
+// Keys returns the keys to unmarshal, to match them ignoring case
+func (m *SubMessage) Keys() []string { return subMessageKeys }
+
 // Reset reset fields
 func (m *SubMessage) Reset() {
 	m.Id = 0
@@ -278,6 +283,11 @@ func (m *Message) UnmarshalJSONObject(dec *gojay.Decoder, k string) error {
 // NKeys returns the number of keys to unmarshal
 func (m *Message) NKeys() int { return 11 }
 
+var messageKeys = []string{"Id", "Name", "Price", "Ints", "Floats", "SubMessageX", "MessagesX", "SubMessageY", "MessagesY", "IsTrue", "Payload"}
+
+// Keys returns the keys to unmarshal, to match them ignoring case
+func (m *Message) Keys() []string { return messageKeys }
+
 // Reset reset fields
 func (m *Message) Reset() {
 	m.Id = 0
@@ -2,7 +2,7 @@ package pooled_struct
 
 import (
 	"bytes"
-	"github.com/francoispqt/gojay"
+	"github.com/jonas747/gojay"
 	"github.com/stretchr/testify/assert"
 	"github.com/viant/assertly"
 	"testing"